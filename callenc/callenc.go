@@ -0,0 +1,388 @@
+package callenc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/scale"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Encode the call data of a pallet's call from JSON arguments, using the types in the metadata.
+// This lets calls be prepared without generating code, e.g. for governance proposals.
+//
+// Arguments are an object keyed by the call's field names, or an array if its fields are unnamed.
+// Values are given as:
+//   - structs: an object keyed by field name, or an array if the fields are unnamed. Structs with a
+//     single field may also be given as the field's value
+//   - enums: the variant's name if it has no fields, or an object with the variant's name as the
+//     only key and its fields as the value. Options may also be given as null
+//   - integers: a number, or a string for integers too large for JSON numbers
+//   - byte sequences and arrays: a 0x-prefixed hex string, or an array of numbers. Byte sequences
+//     may also be given as a plain string
+//   - other sequences, arrays and tuples: an array
+func EncodeCall(meta *types.MetadataV14, palletName, callName string, args []byte) ([]byte, error) {
+	e := newEncoder(meta)
+
+	for _, pallet := range meta.Pallets {
+		if string(pallet.Name) != palletName {
+			continue
+		}
+		if !pallet.HasCalls {
+			return nil, fmt.Errorf("pallet %v has no calls", palletName)
+		}
+		calls, ok := e.types[pallet.Calls.Type.Int64()]
+		if !ok || !calls.Type.Def.IsVariant {
+			return nil, fmt.Errorf("call type of pallet %v is not a variant", palletName)
+		}
+		for _, variant := range calls.Type.Def.Variant.Variants {
+			if string(variant.Name) != callName {
+				continue
+			}
+			var buf bytes.Buffer
+			buf.WriteByte(byte(pallet.Index))
+			buf.WriteByte(byte(variant.Index))
+			if len(bytes.TrimSpace(args)) == 0 {
+				args = []byte("null")
+			}
+			if err := e.encodeFields(&buf, variant.Fields, args); err != nil {
+				return nil, fmt.Errorf("%v.%v: %v", palletName, callName, err)
+			}
+			return buf.Bytes(), nil
+		}
+		return nil, fmt.Errorf("pallet %v has no call %v", palletName, callName)
+	}
+	return nil, fmt.Errorf("pallet %v not found", palletName)
+}
+
+type encoder struct {
+	// A map from ID -> go-rpc-types
+	types map[int64]types.PortableTypeV14
+}
+
+func newEncoder(meta *types.MetadataV14) *encoder {
+	mtypes := map[int64]types.PortableTypeV14{}
+	for _, tdef := range meta.Lookup.Types {
+		mtypes[tdef.ID.Int64()] = tdef
+	}
+	return &encoder{types: mtypes}
+}
+
+// Encode a value of the type with the given id from JSON
+func (e *encoder) encode(buf *bytes.Buffer, typeId int64, value json.RawMessage) error {
+	mt, ok := e.types[typeId]
+	if !ok {
+		return fmt.Errorf("type id=%v not found", typeId)
+	}
+	tdef := mt.Type.Def
+
+	switch {
+	case tdef.IsComposite:
+		fields := tdef.Composite.Fields
+		if len(fields) == 1 {
+			// Single field structs may be given as their field, or as a struct
+			var obj map[string]json.RawMessage
+			if fields[0].Name == "" || json.Unmarshal(value, &obj) != nil || len(obj) != 1 || obj[string(fields[0].Name)] == nil {
+				return e.encode(buf, fields[0].Type.Int64(), value)
+			}
+		}
+		return e.encodeFields(buf, fields, value)
+	case tdef.IsVariant:
+		return e.encodeVariant(buf, &mt, value)
+	case tdef.IsSequence:
+		inner := tdef.Sequence.Type.Int64()
+		if e.isU8(inner) {
+			b, ok, err := decodeBytes(value, true)
+			if err != nil {
+				return err
+			}
+			if ok {
+				if err := writeCompact(buf, big.NewInt(int64(len(b)))); err != nil {
+					return err
+				}
+				buf.Write(b)
+				return nil
+			}
+		}
+		var elems []json.RawMessage
+		if err := json.Unmarshal(value, &elems); err != nil {
+			return fmt.Errorf("expected an array for %v: %v", typeName(&mt), err)
+		}
+		if err := writeCompact(buf, big.NewInt(int64(len(elems)))); err != nil {
+			return err
+		}
+		for _, elem := range elems {
+			if err := e.encode(buf, inner, elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	case tdef.IsArray:
+		inner := tdef.Array.Type.Int64()
+		length := int(tdef.Array.Len)
+		if e.isU8(inner) {
+			b, ok, err := decodeBytes(value, false)
+			if err != nil {
+				return err
+			}
+			if ok {
+				if len(b) != length {
+					return fmt.Errorf("expected %v bytes, got %v", length, len(b))
+				}
+				buf.Write(b)
+				return nil
+			}
+		}
+		var elems []json.RawMessage
+		if err := json.Unmarshal(value, &elems); err != nil {
+			return fmt.Errorf("expected an array for %v: %v", typeName(&mt), err)
+		}
+		if len(elems) != length {
+			return fmt.Errorf("expected %v elements, got %v", length, len(elems))
+		}
+		for _, elem := range elems {
+			if err := e.encode(buf, inner, elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	case tdef.IsTuple:
+		if len(tdef.Tuple) == 0 {
+			return nil
+		}
+		var elems []json.RawMessage
+		if err := json.Unmarshal(value, &elems); err != nil {
+			return fmt.Errorf("expected an array for a tuple: %v", err)
+		}
+		if len(elems) != len(tdef.Tuple) {
+			return fmt.Errorf("expected %v tuple elements, got %v", len(tdef.Tuple), len(elems))
+		}
+		for i, elem := range elems {
+			if err := e.encode(buf, tdef.Tuple[i].Int64(), elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	case tdef.IsPrimitive:
+		return encodePrimitive(buf, tdef.Primitive.Si0TypeDefPrimitive, value)
+	case tdef.IsCompact:
+		v, err := parseInt(value)
+		if err != nil {
+			return err
+		}
+		if v.Sign() < 0 {
+			return fmt.Errorf("compact values can't be negative")
+		}
+		return writeCompact(buf, v)
+	}
+	return fmt.Errorf("can't encode %v from JSON", typeName(&mt))
+}
+
+// Encode the fields of a struct, variant or call, given as an object keyed by field name, or an
+// array if the fields are unnamed
+func (e *encoder) encodeFields(buf *bytes.Buffer, fields []types.Si1Field, value json.RawMessage) error {
+	if len(fields) == 0 {
+		return nil
+	}
+	if fields[0].Name == "" {
+		var elems []json.RawMessage
+		if len(fields) == 1 {
+			elems = []json.RawMessage{value}
+		} else if err := json.Unmarshal(value, &elems); err != nil {
+			return fmt.Errorf("expected an array of %v fields: %v", len(fields), err)
+		}
+		if len(elems) != len(fields) {
+			return fmt.Errorf("expected %v fields, got %v", len(fields), len(elems))
+		}
+		for i, f := range fields {
+			if err := e.encode(buf, f.Type.Int64(), elems[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(value, &obj); err != nil || obj == nil {
+		return fmt.Errorf("expected an object with fields %v", fieldNames(fields))
+	}
+	for _, f := range fields {
+		v, ok := obj[string(f.Name)]
+		if !ok {
+			return fmt.Errorf("missing field %v", f.Name)
+		}
+		if err := e.encode(buf, f.Type.Int64(), v); err != nil {
+			return fmt.Errorf("%v: %v", f.Name, err)
+		}
+		delete(obj, string(f.Name))
+	}
+	for name := range obj {
+		return fmt.Errorf("unknown field %v, expected %v", name, fieldNames(fields))
+	}
+	return nil
+}
+
+// Encode a variant, given as its name, or an object with its name as the only key
+func (e *encoder) encodeVariant(buf *bytes.Buffer, mt *types.PortableTypeV14, value json.RawMessage) error {
+	variants := mt.Type.Def.Variant.Variants
+	find := func(name string) (*types.Si1Variant, error) {
+		for i := range variants {
+			if string(variants[i].Name) == name {
+				return &variants[i], nil
+			}
+		}
+		return nil, fmt.Errorf("%v has no variant %v", typeName(mt), name)
+	}
+
+	var name string
+	var fields json.RawMessage = []byte("null")
+	var obj map[string]json.RawMessage
+	switch {
+	case string(bytes.TrimSpace(value)) == "null":
+		name = "None"
+	case json.Unmarshal(value, &name) == nil:
+	case json.Unmarshal(value, &obj) == nil && len(obj) == 1:
+		for k, v := range obj {
+			name, fields = k, v
+		}
+	default:
+		return fmt.Errorf("expected a variant name, or an object with a variant name as its only key, for %v", typeName(mt))
+	}
+
+	variant, err := find(name)
+	if err != nil {
+		return err
+	}
+	buf.WriteByte(byte(variant.Index))
+	if err := e.encodeFields(buf, variant.Fields, fields); err != nil {
+		return fmt.Errorf("%v: %v", name, err)
+	}
+	return nil
+}
+
+// Whether the type is a u8
+func (e *encoder) isU8(typeId int64) bool {
+	mt := e.types[typeId]
+	return mt.Type.Def.IsPrimitive && mt.Type.Def.Primitive.Si0TypeDefPrimitive == types.IsU8
+}
+
+// Decode a byte string, which is hex if it's 0x-prefixed. Returns false if the value isn't a
+// string. Plain strings are only allowed if `allowText` is set
+func decodeBytes(value json.RawMessage, allowText bool) ([]byte, bool, error) {
+	var s string
+	if json.Unmarshal(value, &s) != nil {
+		return nil, false, nil
+	}
+	if strings.HasPrefix(s, "0x") {
+		b, err := codec.HexDecodeString(s)
+		return b, true, err
+	}
+	if !allowText {
+		return nil, false, fmt.Errorf("expected a 0x-prefixed hex string, got %q", s)
+	}
+	return []byte(s), true, nil
+}
+
+// The sizes of integer primitives, in bytes
+var intSizes = map[types.Si0TypeDefPrimitive]int{
+	types.IsU8: 1, types.IsU16: 2, types.IsU32: 4, types.IsU64: 8, types.IsU128: 16, types.IsU256: 32,
+	types.IsI8: 1, types.IsI16: 2, types.IsI32: 4, types.IsI64: 8, types.IsI128: 16, types.IsI256: 32,
+}
+
+func encodePrimitive(buf *bytes.Buffer, prim types.Si0TypeDefPrimitive, value json.RawMessage) error {
+	switch prim {
+	case types.IsBool:
+		var b bool
+		if err := json.Unmarshal(value, &b); err != nil {
+			return fmt.Errorf("expected a bool: %v", err)
+		}
+		if b {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+		return nil
+	case types.IsStr:
+		var s string
+		if err := json.Unmarshal(value, &s); err != nil {
+			return fmt.Errorf("expected a string: %v", err)
+		}
+		if err := writeCompact(buf, big.NewInt(int64(len(s)))); err != nil {
+			return err
+		}
+		buf.WriteString(s)
+		return nil
+	case types.IsChar:
+		return fmt.Errorf("char is not supported")
+	}
+
+	size := intSizes[prim]
+	signed := prim >= types.IsI8
+	v, err := parseInt(value)
+	if err != nil {
+		return err
+	}
+	// The range of the integer, [min, max)
+	bits := uint(size * 8)
+	min, max := big.NewInt(0), new(big.Int).Lsh(big.NewInt(1), bits)
+	if signed {
+		max.Rsh(max, 1)
+		min.Neg(max)
+	}
+	if v.Cmp(min) < 0 || v.Cmp(max) >= 0 {
+		return fmt.Errorf("%v doesn't fit in %v bytes", v, size)
+	}
+	if v.Sign() < 0 {
+		// Two's complement
+		v = new(big.Int).Add(v, new(big.Int).Lsh(big.NewInt(1), bits))
+	}
+	le := make([]byte, size)
+	be := v.Bytes()
+	for i := range be {
+		le[i] = be[len(be)-1-i]
+	}
+	buf.Write(le)
+	return nil
+}
+
+// Parse an integer from a JSON number or string
+func parseInt(value json.RawMessage) (*big.Int, error) {
+	s := strings.TrimSpace(string(value))
+	var str string
+	if json.Unmarshal(value, &str) == nil {
+		s = str
+	}
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("expected an integer, got %v", s)
+	}
+	return v, nil
+}
+
+func writeCompact(buf *bytes.Buffer, v *big.Int) error {
+	return scale.NewEncoder(buf).EncodeUintCompact(*v)
+}
+
+func fieldNames(fields []types.Si1Field) []string {
+	names := []string{}
+	for _, f := range fields {
+		names = append(names, string(f.Name))
+	}
+	return names
+}
+
+// A readable name for a type in errors
+func typeName(mt *types.PortableTypeV14) string {
+	if len(mt.Type.Path) == 0 {
+		return fmt.Sprintf("type id=%v", mt.ID.Int64())
+	}
+	path := []string{}
+	for _, p := range mt.Type.Path {
+		path = append(path, string(p))
+	}
+	return strings.Join(path, "::")
+}
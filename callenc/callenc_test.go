@@ -0,0 +1,43 @@
+package callenc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/internal/testmeta"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeCall(t *testing.T) {
+	meta := testmeta.Example(t)
+
+	data, err := EncodeCall(meta, "System", "remark", []byte(`{"remark": "0x0102"}`))
+	require.NoError(t, err)
+	require.Equal(t, "0x0001080102", codec.HexEncodeToString(data))
+
+	// Balances (index 6) transfer (index 0) to MultiAddress::Id, with a compact value
+	data, err = EncodeCall(meta, "Balances", "transfer", []byte(`{"dest": {"Id": "0x`+strings.Repeat("11", 32)+`"}, "value": "1000"}`))
+	require.NoError(t, err)
+	require.Equal(t, "0x060000"+strings.Repeat("11", 32)+"a10f", codec.HexEncodeToString(data))
+
+	// Proxy (index 32) proxy (index 0). Options may be null, and fields of a single field struct may be given directly
+	data, err = EncodeCall(meta, "Proxy", "proxy", []byte(`{"real": "0x`+strings.Repeat("22", 32)+`", "force_proxy_type": null, "call": {"System": {"remark": {"remark": "hi"}}}}`))
+	require.NoError(t, err)
+	require.Equal(t, "0x2000"+strings.Repeat("22", 32)+"00"+"0001086869", codec.HexEncodeToString(data))
+}
+
+func TestEncodeCallErrors(t *testing.T) {
+	meta := testmeta.Example(t)
+
+	_, err := EncodeCall(meta, "Nope", "remark", nil)
+	require.ErrorContains(t, err, "pallet Nope not found")
+	_, err = EncodeCall(meta, "System", "nope", nil)
+	require.ErrorContains(t, err, "has no call nope")
+	_, err = EncodeCall(meta, "System", "remark", []byte(`{}`))
+	require.ErrorContains(t, err, "missing field remark")
+	_, err = EncodeCall(meta, "System", "remark", []byte(`{"remark": "0x01", "other": 1}`))
+	require.ErrorContains(t, err, "unknown field other")
+	_, err = EncodeCall(meta, "System", "set_heap_pages", []byte(`{"pages": -1}`))
+	require.ErrorContains(t, err, "doesn't fit in 8 bytes")
+}
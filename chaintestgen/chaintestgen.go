@@ -0,0 +1,442 @@
+package chaintestgen
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/dave/jennifer/jen"
+)
+
+// The chaintest generator generates a package with an in-memory fake of a node's storage, which
+// implements the storage interfaces generated by the TypeGenerator, so code using the generated
+// storage getters can be tested without a node. Values are seeded with the Set{Pallet}{Item}
+// functions generated for each storage item, and are keyed by the real storage keys. The setters
+// live here rather than in the pallet packages, so production code never sees them.
+type ChainTestGenerator struct {
+	F       *jen.File
+	pkgPath string
+	meta    *types.MetadataV14
+	tygen   *typegen.TypeGenerator
+}
+
+func NewChainTestGenerator(pkgPath string, meta *types.MetadataV14, tygen *typegen.TypeGenerator) ChainTestGenerator {
+	F := jen.NewFilePath(pkgPath)
+	F.PackageComment("Package chaintest is an in-memory fake of a node's storage, for testing code which uses the")
+	F.PackageComment("generated storage functions without a node.")
+	return ChainTestGenerator{F: F, pkgPath: pkgPath, meta: meta, tygen: tygen}
+}
+
+// Generate the fake state and the setters, and return the file as a string
+func (cg *ChainTestGenerator) Generate() (string, error) {
+	cg.generateState()
+	cg.generateReads()
+	cg.generateQueries()
+	if err := cg.generateSetters(); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%#v", cg.F), nil
+}
+
+// The receiver of the State's methods
+func recv() *jen.Statement {
+	return jen.Func().Params(jen.Id("s").Op("*").Id("State"))
+}
+
+// The params of a method implementing one of the storage interfaces, after the context if enabled
+func (cg *ChainTestGenerator) params(ps ...jen.Code) *jen.Statement {
+	if cg.tygen.WithContext {
+		ps = append([]jen.Code{jen.Id("ctx").Qual("context", "Context")}, ps...)
+	}
+	return jen.Params(ps...)
+}
+
+// Return the context's error, if it's done, from a method returning (`zero`, error)
+func (cg *ChainTestGenerator) ctxCheck(g *jen.Group, zero jen.Code) {
+	if cg.tygen.WithContext {
+		g.If(jen.Err().Op(":=").Id("ctx").Dot("Err").Call(), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(zero, jen.Err()),
+		)
+	}
+}
+
+// Generate the State, which holds the latest values and the snapshots of committed blocks, and the
+// methods changing it.
+//
+// example output (shortened):
+//
+//	type State struct {
+//		mu      sync.RWMutex
+//		latest  map[string][]byte
+//		pending bool
+//		blocks  []block
+//	}
+//
+//	func NewState() *State {...}
+//
+//	func (s *State) SetStorage(key types.StorageKey, value interface{}) error {...}
+//	func (s *State) SetStorageRaw(key types.StorageKey, data []byte) {...}
+//	func (s *State) DeleteStorage(key types.StorageKey) {...}
+//	func (s *State) Commit(hash types.Hash) {...}
+func (cg *ChainTestGenerator) generateState() {
+	f := cg.F
+	key := func() *jen.Statement { return jen.Id("key").Qual(utils.CTYPES, "StorageKey") }
+	lock := func(g *jen.Group) {
+		g.Id("s").Dot("mu").Dot("Lock").Call()
+		g.Defer().Id("s").Dot("mu").Dot("Unlock").Call()
+	}
+
+	f.Comment("An in-memory chain state, which the generated storage functions read like a node's. Values are")
+	f.Comment("set into the latest state, with SetStorage or the Set{Pallet}{Item} functions, and Commit")
+	f.Comment("snapshots the latest state as a block, to read it at that block hash later. Changes since the")
+	f.Comment("last commit make the latest state a pending block after the committed ones, with the zero hash,")
+	f.Comment("which every read and query of the latest block sees. Subscriptions aren't supported. It is safe")
+	f.Comment("for concurrent use.")
+	f.Type().Id("State").Struct(
+		jen.Id("mu").Qual("sync", "RWMutex"),
+		jen.Comment("Encoded values by the hex of their storage key"),
+		jen.Id("latest").Map(jen.String()).Index().Byte(),
+		jen.Comment("Whether the latest state changed since the last commit"),
+		jen.Id("pending").Bool(),
+		jen.Comment("Committed blocks, in order"),
+		jen.Id("blocks").Index().Id("block"),
+	)
+	f.Line()
+
+	f.Comment("The values at a committed block")
+	f.Type().Id("block").Struct(
+		jen.Id("hash").Qual(utils.CTYPES, "Hash"),
+		jen.Id("values").Map(jen.String()).Index().Byte(),
+	)
+	f.Line()
+
+	f.Comment("Sets storage values, like State and the mocknode package's Node. The Set{Pallet}{Item} functions")
+	f.Comment("write through it.")
+	f.Type().Id("Writer").Interface(
+		jen.Id("SetStorage").Params(key(), jen.Id("value").Interface()).Error(),
+	)
+	f.Line()
+
+	for _, name := range []string{typegen.StorageReaderName, typegen.StorageQuerierName} {
+		f.Var().Id("_").Custom(utils.TypeOpts, cg.tygen.BackendCode(name)).Op("=").Op("&").Id("State").Values()
+	}
+	f.Var().Id("_").Id("Writer").Op("=").Op("&").Id("State").Values()
+	f.Line()
+
+	f.Comment("Create an empty state, without any blocks")
+	f.Func().Id("NewState").Params().Op("*").Id("State").Block(
+		jen.Return(jen.Op("&").Id("State").Values(jen.Dict{jen.Id("latest"): jen.Map(jen.String()).Index().Byte().Values()})),
+	)
+	f.Line()
+
+	f.Comment("Set the SCALE encoding of a value at a storage key in the latest state")
+	f.Add(recv()).Id("SetStorage").Params(key(), jen.Id("value").Interface()).Error().BlockFunc(func(g *jen.Group) {
+		g.List(jen.Id("data"), jen.Err()).Op(":=").Qual(utils.CCODEC, "Encode").Call(jen.Id("value"))
+		utils.ErrorCheckG(g)
+		g.Id("s").Dot("SetStorageRaw").Call(jen.Id("key"), jen.Id("data"))
+		g.Return(jen.Nil())
+	})
+	f.Line()
+
+	f.Comment("Set encoded data at a storage key in the latest state")
+	f.Add(recv()).Id("SetStorageRaw").Params(key(), jen.Id("data").Index().Byte()).BlockFunc(func(g *jen.Group) {
+		lock(g)
+		g.Id("s").Dot("latest").Index(jen.Id("key").Dot("Hex").Call()).Op("=").Append(jen.Index().Byte().Values(), jen.Id("data").Op("..."))
+		g.Id("s").Dot("pending").Op("=").True()
+	})
+	f.Line()
+
+	f.Comment("Remove the value at a storage key from the latest state")
+	f.Add(recv()).Id("DeleteStorage").Params(key()).BlockFunc(func(g *jen.Group) {
+		lock(g)
+		g.Delete(jen.Id("s").Dot("latest"), jen.Id("key").Dot("Hex").Call())
+		g.Id("s").Dot("pending").Op("=").True()
+	})
+	f.Line()
+
+	f.Comment("Snapshot the latest state as the block `hash`. Later changes to the latest state don't change it.")
+	f.Add(recv()).Id("Commit").Params(jen.Id("hash").Qual(utils.CTYPES, "Hash")).BlockFunc(func(g *jen.Group) {
+		lock(g)
+		g.Id("values").Op(":=").Make(jen.Map(jen.String()).Index().Byte(), jen.Len(jen.Id("s").Dot("latest")))
+		// Values are copied when they're set and never modified, so they can be shared
+		g.For(jen.List(jen.Id("k"), jen.Id("v")).Op(":=").Range().Id("s").Dot("latest")).Block(
+			jen.Id("values").Index(jen.Id("k")).Op("=").Id("v"),
+		)
+		g.Id("s").Dot("blocks").Op("=").Append(jen.Id("s").Dot("blocks"), jen.Id("block").Values(jen.Dict{
+			jen.Id("hash"):   jen.Id("hash"),
+			jen.Id("values"): jen.Id("values"),
+		}))
+		g.Id("s").Dot("pending").Op("=").False()
+	})
+	f.Line()
+
+	f.Comment("Get the index of a committed block")
+	f.Add(recv()).Id("index").Params(jen.Id("hash").Qual(utils.CTYPES, "Hash")).Params(jen.Int(), jen.Error()).Block(
+		jen.For(jen.List(jen.Id("i"), jen.Id("b")).Op(":=").Range().Id("s").Dot("blocks")).Block(
+			jen.If(jen.Id("b").Dot("hash").Op("==").Id("hash")).Block(jen.Return(jen.Id("i"), jen.Nil())),
+		),
+		jen.Return(jen.Lit(0), jen.Qual("fmt", "Errorf").Call(jen.Lit("block %v was never committed"), jen.Id("hash").Dot("Hex").Call())),
+	)
+	f.Line()
+
+	f.Comment("Get the hash of the block the latest state belongs to: the zero hash of the pending block, or")
+	f.Comment("the last committed block if nothing changed since. Without any blocks, it's the zero hash too.")
+	f.Add(recv()).Id("head").Params().Qual(utils.CTYPES, "Hash").Block(
+		jen.If(jen.Id("s").Dot("pending").Op("||").Len(jen.Id("s").Dot("blocks")).Op("==").Lit(0)).Block(
+			jen.Return(jen.Qual(utils.CTYPES, "Hash").Values()),
+		),
+		jen.Return(jen.Id("s").Dot("blocks").Index(jen.Len(jen.Id("s").Dot("blocks")).Op("-").Lit(1)).Dot("hash")),
+	)
+	f.Line()
+}
+
+// Generate the StorageReader methods, reading a committed block or the latest state.
+//
+// example output:
+//
+//	func (s *State) GetStorage(key types.StorageKey, target interface{}, blockHash types.Hash) (ok bool, err error) {
+//		s.mu.RLock()
+//		defer s.mu.RUnlock()
+//		i, err := s.index(blockHash)
+//		if err != nil {
+//			return false, err
+//		}
+//		return get(s.blocks[i].values, key, target)
+//	}
+func (cg *ChainTestGenerator) generateReads() {
+	f := cg.F
+	key := jen.Id("key").Qual(utils.CTYPES, "StorageKey")
+	target := jen.Id("target").Interface()
+	rlock := func(g *jen.Group) {
+		g.Id("s").Dot("mu").Dot("RLock").Call()
+		g.Defer().Id("s").Dot("mu").Dot("RUnlock").Call()
+	}
+
+	f.Comment("Decode the value at a storage key, if there is one")
+	f.Func().Id("get").Params(
+		jen.Id("values").Map(jen.String()).Index().Byte(), key.Clone(), target.Clone(),
+	).Params(jen.Id("ok").Bool(), jen.Err().Error()).Block(
+		jen.List(jen.Id("data"), jen.Id("ok")).Op(":=").Id("values").Index(jen.Id("key").Dot("Hex").Call()),
+		jen.If(jen.Op("!").Id("ok")).Block(jen.Return(jen.False(), jen.Nil())),
+		jen.Return(jen.True(), jen.Qual(utils.CCODEC, "Decode").Call(jen.Id("data"), jen.Id("target"))),
+	)
+	f.Line()
+
+	f.Add(recv()).Id("GetStorage").Add(cg.params(key.Clone(), target.Clone(), jen.Id("blockHash").Qual(utils.CTYPES, "Hash"))).Params(
+		jen.Id("ok").Bool(), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		cg.ctxCheck(g, jen.False())
+		rlock(g)
+		g.List(jen.Id("i"), jen.Err()).Op(":=").Id("s").Dot("index").Call(jen.Id("blockHash"))
+		g.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.False(), jen.Err()))
+		g.Return(jen.Id("get").Call(jen.Id("s").Dot("blocks").Index(jen.Id("i")).Dot("values"), jen.Id("key"), jen.Id("target")))
+	})
+	f.Line()
+
+	f.Add(recv()).Id("GetStorageLatest").Add(cg.params(key.Clone(), target.Clone())).Params(
+		jen.Id("ok").Bool(), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		cg.ctxCheck(g, jen.False())
+		rlock(g)
+		g.Return(jen.Id("get").Call(jen.Id("s").Dot("latest"), jen.Id("key"), jen.Id("target")))
+	})
+	f.Line()
+}
+
+// Generate the StorageQuerier methods. Like a node, a range query returns a change set for the first
+// block with every key, and one for each later block changing any of the keys, with only those
+// keys. A range query up to the latest block ends with the pending block, if there is one, like
+// GetStorageLatest reads it.
+//
+// example output (shortened):
+//
+//	func changeSet(hash types.Hash, values map[string][]byte, keys []types.StorageKey, prev map[string][]byte) types.StorageChangeSet {...}
+//
+//	func (s *State) QueryStorage(keys []types.StorageKey, startBlock types.Hash, block types.Hash) ([]types.StorageChangeSet, error) {
+//		s.mu.RLock()
+//		defer s.mu.RUnlock()
+//		end, err := s.index(block)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return s.queryRange(keys, startBlock, end)
+//	}
+func (cg *ChainTestGenerator) generateQueries() {
+	f := cg.F
+	keys := func() *jen.Statement { return jen.Id("keys").Index().Qual(utils.CTYPES, "StorageKey") }
+	hash := func(name string) *jen.Statement { return jen.Id(name).Qual(utils.CTYPES, "Hash") }
+	values := func() *jen.Statement { return jen.Map(jen.String()).Index().Byte() }
+	changeSets := func() *jen.Statement {
+		return jen.List(jen.Index().Qual(utils.CTYPES, "StorageChangeSet"), jen.Error())
+	}
+	rlock := func(g *jen.Group) {
+		g.Id("s").Dot("mu").Dot("RLock").Call()
+		g.Defer().Id("s").Dot("mu").Dot("RUnlock").Call()
+	}
+
+	f.Comment("Get the values of the keys at a block. If `prev` isn't nil, only get the values which differ from it.")
+	f.Func().Id("changeSet").Params(
+		hash("hash"), jen.Id("values").Add(values()), keys(), jen.Id("prev").Add(values()),
+	).Qual(utils.CTYPES, "StorageChangeSet").Block(
+		jen.Id("set").Op(":=").Qual(utils.CTYPES, "StorageChangeSet").Values(jen.Dict{
+			jen.Id("Block"):   jen.Id("hash"),
+			jen.Id("Changes"): jen.Index().Qual(utils.CTYPES, "KeyValueOption").Values(),
+		}),
+		jen.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("keys")).Block(
+			jen.List(jen.Id("data"), jen.Id("ok")).Op(":=").Id("values").Index(jen.Id("key").Dot("Hex").Call()),
+			jen.If(jen.Id("prev").Op("!=").Nil()).Block(
+				jen.List(jen.Id("prevData"), jen.Id("prevOk")).Op(":=").Id("prev").Index(jen.Id("key").Dot("Hex").Call()),
+				jen.If(jen.Id("ok").Op("==").Id("prevOk").Op("&&").Qual("bytes", "Equal").Call(jen.Id("data"), jen.Id("prevData"))).Block(
+					jen.Continue(),
+				),
+			),
+			jen.Id("set").Dot("Changes").Op("=").Append(jen.Id("set").Dot("Changes"), jen.Qual(utils.CTYPES, "KeyValueOption").Values(jen.Dict{
+				jen.Id("StorageKey"):     jen.Id("key"),
+				jen.Id("HasStorageData"): jen.Id("ok"),
+				jen.Id("StorageData"):    jen.Id("data"),
+			})),
+		),
+		jen.Return(jen.Id("set")),
+	)
+	f.Line()
+
+	f.Add(recv()).Id("QueryStorageAt").Add(cg.params(keys(), hash("block"))).Params(changeSets()).BlockFunc(func(g *jen.Group) {
+		cg.ctxCheck(g, jen.Nil())
+		rlock(g)
+		g.List(jen.Id("i"), jen.Err()).Op(":=").Id("s").Dot("index").Call(jen.Id("block"))
+		utils.ErrorCheckWithNil(g)
+		g.Return(jen.Index().Qual(utils.CTYPES, "StorageChangeSet").Values(
+			jen.Id("changeSet").Call(jen.Id("block"), jen.Id("s").Dot("blocks").Index(jen.Id("i")).Dot("values"), jen.Id("keys"), jen.Nil()),
+		), jen.Nil())
+	})
+	f.Line()
+
+	f.Add(recv()).Id("QueryStorageAtLatest").Add(cg.params(keys())).Params(changeSets()).BlockFunc(func(g *jen.Group) {
+		cg.ctxCheck(g, jen.Nil())
+		rlock(g)
+		g.Return(jen.Index().Qual(utils.CTYPES, "StorageChangeSet").Values(
+			jen.Id("changeSet").Call(jen.Id("s").Dot("head").Call(), jen.Id("s").Dot("latest"), jen.Id("keys"), jen.Nil()),
+		), jen.Nil())
+	})
+	f.Line()
+
+	f.Comment("Get the changes of the keys from the block `start` to the block at index `end`")
+	f.Add(recv()).Id("queryRange").Params(keys(), hash("start"), jen.Id("end").Int()).Params(changeSets()).BlockFunc(func(g *jen.Group) {
+		g.List(jen.Id("i"), jen.Err()).Op(":=").Id("s").Dot("index").Call(jen.Id("start"))
+		utils.ErrorCheckWithNil(g)
+		g.If(jen.Id("end").Op("<").Id("i")).Block(
+			jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("block %v is after block %v"), jen.Id("start").Dot("Hex").Call(), jen.Id("s").Dot("blocks").Index(jen.Id("end")).Dot("hash").Dot("Hex").Call())),
+		)
+		g.Var().Id("prev").Add(values())
+		g.Id("sets").Op(":=").Index().Qual(utils.CTYPES, "StorageChangeSet").Values()
+		g.For(jen.List(jen.Id("_"), jen.Id("b")).Op(":=").Range().Id("s").Dot("blocks").Index(jen.Id("i").Op(":").Id("end").Op("+").Lit(1))).Block(
+			jen.Id("set").Op(":=").Id("changeSet").Call(jen.Id("b").Dot("hash"), jen.Id("b").Dot("values"), jen.Id("keys"), jen.Id("prev")),
+			jen.If(jen.Id("prev").Op("==").Nil().Op("||").Len(jen.Id("set").Dot("Changes")).Op(">").Lit(0)).Block(
+				jen.Id("sets").Op("=").Append(jen.Id("sets"), jen.Id("set")),
+			),
+			jen.Id("prev").Op("=").Id("b").Dot("values"),
+		)
+		g.Return(jen.Id("sets"), jen.Nil())
+	})
+	f.Line()
+
+	f.Add(recv()).Id("QueryStorage").Add(cg.params(keys(), hash("startBlock"), hash("block"))).Params(changeSets()).BlockFunc(func(g *jen.Group) {
+		cg.ctxCheck(g, jen.Nil())
+		rlock(g)
+		g.List(jen.Id("end"), jen.Err()).Op(":=").Id("s").Dot("index").Call(jen.Id("block"))
+		utils.ErrorCheckWithNil(g)
+		g.Return(jen.Id("s").Dot("queryRange").Call(jen.Id("keys"), jen.Id("startBlock"), jen.Id("end")))
+	})
+	f.Line()
+
+	f.Add(recv()).Id("QueryStorageLatest").Add(cg.params(keys(), hash("startBlock"))).Params(changeSets()).BlockFunc(func(g *jen.Group) {
+		cg.ctxCheck(g, jen.Nil())
+		rlock(g)
+		g.List(jen.Id("sets"), jen.Err()).Op(":=").Id("s").Dot("queryRange").Call(jen.Id("keys"), jen.Id("startBlock"), jen.Len(jen.Id("s").Dot("blocks")).Op("-").Lit(1))
+		utils.ErrorCheckWithNil(g)
+		// The start block was committed, so there is a last one to compare the pending block with
+		g.If(jen.Id("s").Dot("pending")).Block(
+			jen.Id("set").Op(":=").Id("changeSet").Call(
+				jen.Qual(utils.CTYPES, "Hash").Values(), jen.Id("s").Dot("latest"), jen.Id("keys"),
+				jen.Id("s").Dot("blocks").Index(jen.Len(jen.Id("s").Dot("blocks")).Op("-").Lit(1)).Dot("values"),
+			),
+			jen.If(jen.Len(jen.Id("set").Dot("Changes")).Op(">").Lit(0)).Block(
+				jen.Id("sets").Op("=").Append(jen.Id("sets"), jen.Id("set")),
+			),
+		)
+		g.Return(jen.Id("sets"), jen.Nil())
+	})
+}
+
+// Generate a function setting each storage item through a Writer, named after its pallet and taking
+// the same keys as its getters.
+//
+// example output:
+//
+//	// Set Account of the System pallet in a fake state
+//	func SetSystemAccount(state Writer, byteArray320 [32]byte, value types1.AccountInfo) error {
+//		key, err := system.MakeAccountStorageKey(byteArray320)
+//		if err != nil {
+//			return err
+//		}
+//		return state.SetStorage(key, value)
+//	}
+func (cg *ChainTestGenerator) generateSetters() error {
+	for _, pallet := range cg.meta.Pallets {
+		if !pallet.HasStorage {
+			continue
+		}
+		// The pallet packages are next to this one
+		palletPath := path.Join(path.Dir(cg.pkgPath), strings.ToLower(string(pallet.Name)))
+		for _, item := range pallet.Storage.Items {
+			if err := cg.generateSetter(palletPath, string(pallet.Name), item); err != nil {
+				return fmt.Errorf("error generating setter of %v in pallet %v: %v", item.Name, pallet.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (cg *ChainTestGenerator) generateSetter(palletPath string, palletName string, item types.StorageEntryMetadataV14) error {
+	// The key arguments, made like the storage generator makes them
+	args := []jen.Code{jen.Id("state").Id("Writer")}
+	keyArgs := []jen.Code{}
+	var valueId int64
+	if item.Type.IsPlainType {
+		valueId = item.Type.AsPlainType.Int64()
+	} else if item.Type.IsMap {
+		gend, err := cg.tygen.GetType(item.Type.AsMap.Key.Int64())
+		if err != nil {
+			return err
+		}
+		var ind uint32 = 0
+		newArgs, keyArgNames, err := cg.tygen.GenerateArgs(gend, &ind, gend.DisplayName())
+		if err != nil {
+			return err
+		}
+		args = append(args, newArgs...)
+		for _, name := range keyArgNames {
+			keyArgs = append(keyArgs, jen.Id(name))
+		}
+		valueId = item.Type.AsMap.Value.Int64()
+	} else {
+		return fmt.Errorf("unsupported storage type %v", item.Type)
+	}
+	valueGend, err := cg.tygen.GetType(valueId)
+	if err != nil {
+		return err
+	}
+	args = append(args, jen.Id("value").Custom(utils.TypeOpts, valueGend.Code()))
+
+	cg.F.Comment(fmt.Sprintf("Set %v of the %v pallet in a fake state", item.Name, palletName))
+	cg.F.Func().Id(utils.AsName("Set", palletName, string(item.Name))).Params(args...).Error().BlockFunc(func(g *jen.Group) {
+		g.List(jen.Id("key"), jen.Err()).Op(":=").Qual(palletPath, utils.AsName("Make", string(item.Name), "StorageKey")).Call(keyArgs...)
+		utils.ErrorCheckG(g)
+		g.Return(jen.Id("state").Dot("SetStorage").Call(jen.Id("key"), jen.Id("value")))
+	})
+	cg.F.Line()
+	return nil
+}
@@ -0,0 +1,286 @@
+package dispatchgen
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/dave/jennifer/jen"
+)
+
+// The dispatch generator generates a package which picks the code generated for the right runtime
+// version from the spec version at a block, to decode calls, extrinsics, events and storage of
+// historical blocks. The code of each version is generated by its own TypeGenerator.
+type DispatchGenerator struct {
+	F        *jen.File
+	versions []version
+}
+
+type version struct {
+	spec  uint32
+	meta  *types.MetadataV14
+	tygen *typegen.TypeGenerator
+}
+
+func NewDispatchGenerator(pkgPath string) DispatchGenerator {
+	return DispatchGenerator{F: jen.NewFilePath(pkgPath)}
+}
+
+// Add the code generated for a runtime version. The version's types package must have its call
+// helpers and extrinsic type generated.
+func (dg *DispatchGenerator) AddVersion(specVersion uint32, meta *types.MetadataV14, tygen *typegen.TypeGenerator) {
+	dg.versions = append(dg.versions, version{spec: specVersion, meta: meta, tygen: tygen})
+}
+
+// Generate the dispatcher, and return the file as a string. This must be done before the types of
+// each version are written, as it may generate storage types.
+func (dg *DispatchGenerator) Generate() (string, error) {
+	if len(dg.versions) == 0 {
+		return "", fmt.Errorf("no runtime versions to dispatch to")
+	}
+	sort.Slice(dg.versions, func(i, j int) bool { return dg.versions[i].spec < dg.versions[j].spec })
+	for i := 1; i < len(dg.versions); i++ {
+		if dg.versions[i].spec == dg.versions[i-1].spec {
+			return "", fmt.Errorf("spec version %v given twice", dg.versions[i].spec)
+		}
+	}
+
+	dg.generateVersionFor()
+	dg.generateMetadata()
+	if err := dg.generateDecodeCall(); err != nil {
+		return "", err
+	}
+	if err := dg.generateDecodeExtrinsic(); err != nil {
+		return "", err
+	}
+	if err := dg.generateDecodeStorage(); err != nil {
+		return "", err
+	}
+	dg.generateDecodeEvents()
+	return fmt.Sprintf("%#v", dg.F), nil
+}
+
+// Generate the list of spec versions, and the function picking the version used for a block.
+//
+// example output:
+//
+//	var SpecVersions = []uint32{9300, 9370}
+//
+//	func VersionFor(specVersion uint32) (uint32, error) {
+//		for i := len(SpecVersions) - 1; i >= 0; i-- {
+//			if SpecVersions[i] <= specVersion {
+//				return SpecVersions[i], nil
+//			}
+//		}
+//		return 0, fmt.Errorf("no code generated for spec version %v", specVersion)
+//	}
+func (dg *DispatchGenerator) generateVersionFor() {
+	specs := []jen.Code{}
+	for _, v := range dg.versions {
+		specs = append(specs, jen.Lit(int(v.spec)))
+	}
+	dg.F.Comment("The spec versions code was generated for, in ascending order")
+	dg.F.Var().Id("SpecVersions").Op("=").Index().Uint32().Values(specs...)
+
+	dg.F.Comment("Get the spec version whose generated code is used for blocks of the given spec version: the")
+	dg.F.Comment("latest one which isn't after it, as runtime upgrades don't always change the metadata")
+	dg.F.Func().Id("VersionFor").Params(jen.Id("specVersion").Uint32()).Params(jen.Uint32(), jen.Error()).Block(
+		jen.For(
+			jen.Id("i").Op(":=").Len(jen.Id("SpecVersions")).Op("-").Lit(1),
+			jen.Id("i").Op(">=").Lit(0),
+			jen.Id("i").Op("--"),
+		).Block(
+			jen.If(jen.Id("SpecVersions").Index(jen.Id("i")).Op("<=").Id("specVersion")).Block(
+				jen.Return(jen.Id("SpecVersions").Index(jen.Id("i")), jen.Nil()),
+			),
+		),
+		jen.Return(jen.Lit(0), jen.Qual("fmt", "Errorf").Call(jen.Lit("no code generated for spec version %v"), jen.Id("specVersion"))),
+	)
+}
+
+// Generate a function which switches on the version used for the given spec version. `ret` holds
+// the zero values returned with errors, and `versionCase` generates the body of each version's case.
+//
+// example output:
+//
+//	func DecodeCall(specVersion uint32, data []byte) (any, error) {
+//		version, err := VersionFor(specVersion)
+//		if err != nil {
+//			return nil, err
+//		}
+//		switch version {
+//		case 9300:
+//			...
+//		}
+//		return nil, fmt.Errorf("no code generated for spec version %v", specVersion)
+//	}
+func (dg *DispatchGenerator) generateSwitch(name string, params []jen.Code, results []jen.Code, ret []jen.Code, versionCase func(v *version, g *jen.Group) error) error {
+	cases := []jen.Code{}
+	for i := range dg.versions {
+		var err error
+		cases = append(cases, jen.Case(jen.Lit(int(dg.versions[i].spec))).BlockFunc(func(g *jen.Group) {
+			err = versionCase(&dg.versions[i], g)
+		}))
+		if err != nil {
+			return err
+		}
+	}
+
+	dg.F.Func().Id(name).Params(append([]jen.Code{jen.Id("specVersion").Uint32()}, params...)...).Params(results...).Block(
+		jen.List(jen.Id("version"), jen.Err()).Op(":=").Id("VersionFor").Call(jen.Id("specVersion")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(append(ret, jen.Err())...)),
+		jen.Switch(jen.Id("version")).Block(cases...),
+		jen.Return(append(ret, jen.Qual("fmt", "Errorf").Call(jen.Lit("no code generated for spec version %v"), jen.Id("specVersion")))...),
+	)
+	return nil
+}
+
+// Generate a function returning the metadata of a version, e.g. to make storage keys.
+//
+// example output:
+//
+//	func Metadata(specVersion uint32) (*types.Metadata, error) {
+//		...
+//		case 9300:
+//			return &types1.Meta, nil
+//		...
+//	}
+func (dg *DispatchGenerator) generateMetadata() {
+	dg.F.Comment("Get the metadata of the runtime version used for blocks of the given spec version, e.g. to make")
+	dg.F.Comment("storage keys")
+	// The cases never fail
+	_ = dg.generateSwitch("Metadata", nil,
+		[]jen.Code{jen.Op("*").Qual(utils.CTYPES, "Metadata"), jen.Error()},
+		[]jen.Code{jen.Nil()},
+		func(v *version, g *jen.Group) error {
+			g.Return(jen.Op("&").Add(v.tygen.MetaCode()), jen.Nil())
+			return nil
+		},
+	)
+}
+
+// Generate a function decoding call data with the RuntimeCall type of the right version.
+//
+// example output:
+//
+//	func DecodeCall(specVersion uint32, data []byte) (any, error) {
+//		...
+//		case 9300:
+//			return types1.DecodeCallData(data)
+//		...
+//	}
+func (dg *DispatchGenerator) generateDecodeCall() error {
+	dg.F.Comment("Decode call data with the RuntimeCall type of the runtime version used for blocks of the given")
+	dg.F.Comment("spec version")
+	return dg.generateSwitch("DecodeCall",
+		[]jen.Code{jen.Id("data").Index().Byte()},
+		[]jen.Code{jen.Any(), jen.Error()},
+		[]jen.Code{jen.Nil()},
+		func(v *version, g *jen.Group) error {
+			if _, err := v.tygen.GetCallType(); err != nil {
+				return fmt.Errorf("spec version %v: %v", v.spec, err)
+			}
+			g.Return(jen.Qual(v.tygen.PkgPath, "DecodeCallData").Call(jen.Id("data")))
+			return nil
+		},
+	)
+}
+
+// Generate a function decoding an extrinsic with the Extrinsic type of the right version.
+//
+// example output:
+//
+//	func DecodeExtrinsic(specVersion uint32, data []byte) (any, error) {
+//		...
+//		case 9300:
+//			return types1.DecodeExtrinsic(data)
+//		...
+//	}
+func (dg *DispatchGenerator) generateDecodeExtrinsic() error {
+	dg.F.Comment("Decode an extrinsic with the Extrinsic type of the runtime version used for blocks of the given")
+	dg.F.Comment("spec version")
+	return dg.generateSwitch("DecodeExtrinsic",
+		[]jen.Code{jen.Id("data").Index().Byte()},
+		[]jen.Code{jen.Any(), jen.Error()},
+		[]jen.Code{jen.Nil()},
+		func(v *version, g *jen.Group) error {
+			if _, err := v.tygen.GetExtrinsicType(&v.meta.Extrinsic); err != nil {
+				return fmt.Errorf("spec version %v: %v", v.spec, err)
+			}
+			g.Return(jen.Qual(v.tygen.PkgPath, "DecodeExtrinsic").Call(jen.Id("data")))
+			return nil
+		},
+	)
+}
+
+// Generate a function decoding a storage value with the type of the storage entry in the right
+// version. Entries are named by their pallet and item names, e.g. "System", "Account".
+//
+// example output:
+//
+//	func DecodeStorage(specVersion uint32, pallet string, item string, data []byte) (any, error) {
+//		...
+//		case 9300:
+//			switch pallet + "." + item {
+//			case "System.Account":
+//				var ret types1.AccountInfo
+//				err = codec.Decode(data, &ret)
+//				return ret, err
+//			...
+//			}
+//		...
+//	}
+func (dg *DispatchGenerator) generateDecodeStorage() error {
+	dg.F.Comment("Decode a storage value with the type of the storage entry in the runtime version used for blocks")
+	dg.F.Comment(`of the given spec version. Entries are named by their pallet and item, e.g. "System", "Account"`)
+	return dg.generateSwitch("DecodeStorage",
+		[]jen.Code{jen.Id("pallet").String(), jen.Id("item").String(), jen.Id("data").Index().Byte()},
+		[]jen.Code{jen.Any(), jen.Error()},
+		[]jen.Code{jen.Nil()},
+		func(v *version, g *jen.Group) error {
+			items := []jen.Code{}
+			for _, pallet := range v.meta.Pallets {
+				if !pallet.HasStorage {
+					continue
+				}
+				for _, item := range pallet.Storage.Items {
+					valueId := item.Type.AsMap.Value
+					if item.Type.IsPlainType {
+						valueId = item.Type.AsPlainType
+					}
+					gend, err := v.tygen.GetType(valueId.Int64())
+					if err != nil {
+						return fmt.Errorf("spec version %v, storage %v.%v: %v", v.spec, pallet.Name, item.Name, err)
+					}
+					items = append(items, jen.Case(jen.Lit(fmt.Sprintf("%v.%v", pallet.Name, item.Name))).Block(
+						jen.Var().Id("ret").Custom(utils.TypeOpts, gend.Code()),
+						jen.Err().Op("=").Qual(utils.CCODEC, "Decode").Call(jen.Id("data"), jen.Op("&").Id("ret")),
+						jen.Return(jen.Id("ret"), jen.Err()),
+					))
+				}
+			}
+			g.Switch(jen.Id("pallet").Op("+").Lit(".").Op("+").Id("item")).Block(items...)
+			g.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(
+				jen.Lit("storage %v.%v not found in spec version %v"), jen.Id("pallet"), jen.Id("item"), jen.Id("version"),
+			))
+			return nil
+		},
+	)
+}
+
+// Generate a function decoding the events of a block, the value of System.Events.
+//
+// example output:
+//
+//	func DecodeEvents(specVersion uint32, data []byte) (any, error) {
+//		return DecodeStorage(specVersion, "System", "Events", data)
+//	}
+func (dg *DispatchGenerator) generateDecodeEvents() {
+	dg.F.Comment("Decode the events of a block, the value of System.Events, with the types of the runtime version")
+	dg.F.Comment("used for blocks of the given spec version")
+	dg.F.Func().Id("DecodeEvents").Params(jen.Id("specVersion").Uint32(), jen.Id("data").Index().Byte()).Params(jen.Any(), jen.Error()).Block(
+		jen.Return(jen.Id("DecodeStorage").Call(jen.Id("specVersion"), jen.Lit("System"), jen.Lit("Events"), jen.Id("data"))),
+	)
+}
@@ -0,0 +1,353 @@
+package docgen
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// The doc generator renders a Markdown reference of a runtime's API in terms of the generated go
+// code: an index of the pallets, a page per pallet with its calls, storage items, events, errors and
+// constants, and a page with every generated type, linking each to its original Rust path.
+//
+// It must run once the code is generated, and only looks up types the TypeGenerator already
+// generated, so asking for docs never changes the code. Types which no generated code uses have no
+// go type.
+type DocGenerator struct {
+	meta    *types.MetadataV14
+	pkgPath string
+	tygen   *typegen.TypeGenerator
+	// The metadata's types, by id
+	lookup map[int64]types.PortableTypeV14
+}
+
+// The page listing the generated types, which the other pages link to
+const typesPage = "types.md"
+
+func NewDocGenerator(meta *types.MetadataV14, pkgPath string, tygen *typegen.TypeGenerator) DocGenerator {
+	lookup := map[int64]types.PortableTypeV14{}
+	for _, mt := range meta.Lookup.Types {
+		lookup[mt.ID.Int64()] = mt
+	}
+	return DocGenerator{meta: meta, pkgPath: pkgPath, tygen: tygen, lookup: lookup}
+}
+
+// Generate the pages, by their file name
+func (dg *DocGenerator) Generate() map[string][]byte {
+	files := map[string][]byte{
+		"index.md": []byte(dg.index()),
+		typesPage:  []byte(dg.typesPage()),
+	}
+	for _, pallet := range dg.meta.Pallets {
+		files[palletFile(&pallet)] = []byte(dg.palletPage(&pallet))
+	}
+	return files
+}
+
+// The page of a pallet is named after its package
+func palletFile(pallet *types.PalletMetadataV14) string {
+	return strings.ToLower(string(pallet.Name)) + ".md"
+}
+
+// Generate the index of the pallets.
+//
+// example output:
+//
+//	# API reference
+//
+//	The pallets of the runtime, generated into `example.com/chain`. See also the [types](types.md).
+//
+//	| Pallet | Index | Calls | Storage items | Events | Errors | Constants |
+//	| --- | --- | --- | --- | --- | --- | --- |
+//	| [System](system.md) | 0 | 1 | 2 | 2 | 1 | 0 |
+func (dg *DocGenerator) index() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "# API reference\n\n")
+	fmt.Fprintf(b, "The pallets of the runtime, generated into `%v`. See also the [types](%v).\n\n", dg.pkgPath, typesPage)
+	fmt.Fprintf(b, "| Pallet | Index | Calls | Storage items | Events | Errors | Constants |\n")
+	fmt.Fprintf(b, "| --- | --- | --- | --- | --- | --- | --- |\n")
+	for _, pallet := range dg.meta.Pallets {
+		fmt.Fprintf(b, "| [%v](%v) | %v | %v | %v | %v | %v | %v |\n",
+			pallet.Name, palletFile(&pallet), pallet.Index,
+			len(dg.variants(pallet.HasCalls, pallet.Calls.Type)),
+			len(pallet.Storage.Items),
+			len(dg.variants(pallet.HasEvents, pallet.Events.Type)),
+			len(dg.variants(pallet.HasErrors, pallet.Errors.Type)),
+			len(pallet.Constants),
+		)
+	}
+	return b.String()
+}
+
+// Get the variants of a pallet's calls, events or errors, if it has them
+func (dg *DocGenerator) variants(has bool, id types.Si1LookupTypeID) []types.Si1Variant {
+	if !has {
+		return nil
+	}
+	def := dg.mtype(id.Int64()).Type.Def
+	if !def.IsVariant {
+		return nil
+	}
+	return def.Variant.Variants
+}
+
+func (dg *DocGenerator) mtype(id int64) types.PortableTypeV14 {
+	return dg.lookup[id]
+}
+
+// Generate the page of a pallet
+func (dg *DocGenerator) palletPage(pallet *types.PalletMetadataV14) string {
+	b := &strings.Builder{}
+	pkgName := strings.ToLower(string(pallet.Name))
+	fmt.Fprintf(b, "# %v\n\n", pallet.Name)
+	fmt.Fprintf(b, "Pallet index %v, generated into `%v`. Back to the [index](index.md).\n", pallet.Index, path.Join(dg.pkgPath, pkgName))
+
+	if calls := dg.variants(pallet.HasCalls, pallet.Calls.Type); len(calls) > 0 {
+		fmt.Fprintf(b, "\n## Calls\n")
+		for _, call := range calls {
+			fmt.Fprintf(b, "\n### %v\n\n", call.Name)
+			writeDocs(b, call.Docs)
+			fmt.Fprintf(b, "Made by `%v.%v`.\n", pkgName, utils.AsName("Make", string(call.Name), "Call"))
+			dg.fieldTable(b, "Argument", call.Fields)
+		}
+	}
+
+	if pallet.HasStorage && len(pallet.Storage.Items) > 0 {
+		fmt.Fprintf(b, "\n## Storage\n")
+		for _, item := range pallet.Storage.Items {
+			dg.storageItem(b, pkgName, &item)
+		}
+	}
+
+	if events := dg.variants(pallet.HasEvents, pallet.Events.Type); len(events) > 0 {
+		fmt.Fprintf(b, "\n## Events\n")
+		for _, event := range events {
+			fmt.Fprintf(b, "\n### %v\n\n", event.Name)
+			writeDocs(b, event.Docs)
+			fmt.Fprintf(b, "Index %v.\n", event.Index)
+			dg.fieldTable(b, "Field", event.Fields)
+		}
+	}
+
+	if errors := dg.variants(pallet.HasErrors, pallet.Errors.Type); len(errors) > 0 {
+		fmt.Fprintf(b, "\n## Errors\n\n")
+		fmt.Fprintf(b, "| Error | Index | Description |\n| --- | --- | --- |\n")
+		for _, e := range errors {
+			fmt.Fprintf(b, "| %v | %v | %v |\n", e.Name, e.Index, cell(e.Docs))
+		}
+	}
+
+	if len(pallet.Constants) > 0 {
+		fmt.Fprintf(b, "\n## Constants\n\n")
+		fmt.Fprintf(b, "| Constant | Go type | Rust type | Value | Description |\n| --- | --- | --- | --- | --- |\n")
+		for _, c := range pallet.Constants {
+			fmt.Fprintf(b, "| %v | %v | %v | `%v` | %v |\n", c.Name, dg.goType(c.Type.Int64()), dg.rustType(c.Type.Int64(), ""), codec.HexEncodeToString(c.Value), cell(c.Docs))
+		}
+	}
+	return b.String()
+}
+
+// Document a storage item, with its getter, key and value types, hashers and default.
+//
+// example output:
+//
+//	### Account
+//
+//	The balances of an account.
+//
+//	Read with `balances.GetAccount` and `balances.GetAccountLatest`.
+//
+//	| | Go type | Rust type |
+//	| --- | --- | --- |
+//	| Key | `[32]byte` | `sp_core::crypto::AccountId32` |
+//	| Value | [`AccountData`](types.md#accountdata) | `pallet_balances::AccountData` |
+//
+//	Hashed with Blake2_128Concat. Defaults to `0x00`.
+func (dg *DocGenerator) storageItem(b *strings.Builder, pkgName string, item *types.StorageEntryMetadataV14) {
+	fmt.Fprintf(b, "\n### %v\n\n", item.Name)
+	writeDocs(b, item.Documentation)
+	fmt.Fprintf(b, "Read with `%v.%v` and `%v.%v`.\n\n", pkgName, utils.AsName("Get", string(item.Name)), pkgName, utils.AsName("Get", string(item.Name), "Latest"))
+	fmt.Fprintf(b, "| | Go type | Rust type |\n| --- | --- | --- |\n")
+
+	var value types.Si1LookupTypeID
+	hashers := []string{}
+	if item.Type.IsMap {
+		key := item.Type.AsMap.Key.Int64()
+		fmt.Fprintf(b, "| Key | %v | %v |\n", dg.goType(key), dg.rustType(key, ""))
+		value = item.Type.AsMap.Value
+		for _, h := range item.Type.AsMap.Hashers {
+			hashers = append(hashers, utils.HasherName(h))
+		}
+	} else {
+		value = item.Type.AsPlainType
+	}
+	fmt.Fprintf(b, "| Value | %v | %v |\n\n", dg.goType(value.Int64()), dg.rustType(value.Int64(), ""))
+
+	if len(hashers) > 0 {
+		fmt.Fprintf(b, "Hashed with %v. ", strings.Join(hashers, ", "))
+	}
+	if item.Modifier.IsDefault {
+		fmt.Fprintf(b, "Defaults to `%v`.\n", codec.HexEncodeToString(item.Fallback))
+	} else {
+		fmt.Fprintf(b, "Optional.\n")
+	}
+}
+
+// Write a table of the fields of a call, event or type
+func (dg *DocGenerator) fieldTable(b *strings.Builder, kind string, fields []types.Si1Field) {
+	if len(fields) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n| %v | Go type | Rust type |\n| --- | --- | --- |\n", kind)
+	for i, f := range fields {
+		name := string(f.Name)
+		if !f.HasName {
+			name = fmt.Sprint(i)
+		}
+		typeName := ""
+		if f.HasTypeName {
+			typeName = string(f.TypeName)
+		}
+		fmt.Fprintf(b, "| %v | %v | %v |\n", name, dg.goType(f.Type.Int64()), dg.rustType(f.Type.Int64(), typeName))
+	}
+}
+
+// Generate the page of the generated types, in order of name.
+//
+// example output:
+//
+//	### AccountData
+//
+//	Rust type `pallet_balances::AccountData`.
+//
+//	| Field | Go type | Rust type |
+//	| --- | --- | --- |
+//	| Free | `types.U128` | `Balance` |
+func (dg *DocGenerator) typesPage() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "# Types\n\n")
+	fmt.Fprintf(b, "The types generated into `%v`. Back to the [index](index.md).\n", dg.tygen.PkgPath)
+
+	defined := dg.tygen.DefinedTypes()
+	names := make([]string, 0, len(defined))
+	for name := range defined {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		mt := defined[name].MType()
+		fmt.Fprintf(b, "\n### %v\n\n", name)
+		writeDocs(b, mt.Type.Docs)
+		fmt.Fprintf(b, "Rust type %v.\n", dg.rustType(mt.ID.Int64(), ""))
+
+		def := mt.Type.Def
+		switch {
+		case def.IsComposite:
+			dg.fieldTable(b, "Field", def.Composite.Fields)
+		case def.IsVariant:
+			fmt.Fprintf(b, "\n| Variant | Index | Fields |\n| --- | --- | --- |\n")
+			for _, v := range def.Variant.Variants {
+				fields := []string{}
+				for i, f := range v.Fields {
+					name := string(f.Name)
+					if !f.HasName {
+						name = fmt.Sprint(i)
+					}
+					fields = append(fields, fmt.Sprintf("%v: %v", name, dg.goType(f.Type.Int64())))
+				}
+				fmt.Fprintf(b, "| %v | %v | %v |\n", v.Name, v.Index, strings.Join(fields, ", "))
+			}
+		case def.IsTuple:
+			fmt.Fprintf(b, "\n| Element | Go type | Rust type |\n| --- | --- | --- |\n")
+			for i, id := range def.Tuple {
+				fmt.Fprintf(b, "| %v | %v | %v |\n", i, dg.goType(id.Int64()), dg.rustType(id.Int64(), ""))
+			}
+		}
+	}
+	return b.String()
+}
+
+// Get the markdown for the go type of a type id, linking to its definition if it's in the types
+// page, or a note if no generated code uses it
+func (dg *DocGenerator) goType(id int64) string {
+	gend, ok := dg.tygen.Generated(id)
+	if !ok {
+		return "_not generated_"
+	}
+	// Link slices and arrays of defined types too
+	inner := gend
+	for {
+		switch g := inner.(type) {
+		case *typegen.SliceGend:
+			inner = g.Inner
+			continue
+		case *typegen.ArrayGend:
+			inner = g.Inner
+			continue
+		}
+		break
+	}
+	code := fmt.Sprintf("%#v", gend.Code())
+	name, ok := dg.definedName(inner)
+	if !ok {
+		return "`" + code + "`"
+	}
+	// Drop the qualifier of the types package, as the types page does
+	code = strings.Replace(code, fmt.Sprintf("%#v", inner.Code()), name, 1)
+	return fmt.Sprintf("[`%v`](%v#%v)", code, typesPage, strings.ToLower(name))
+}
+
+// Get the name of a type defined in the types package
+func (dg *DocGenerator) definedName(gend typegen.GeneratedType) (string, bool) {
+	var g *typegen.Gend
+	switch gend := gend.(type) {
+	case *typegen.CompositeGend:
+		g = &gend.Gend
+	case *typegen.VariantGend:
+		g = &gend.Gend
+	case *typegen.Gend:
+		g = gend
+	default:
+		return "", false
+	}
+	return g.Name, g.Pkg == dg.tygen.PkgPath
+}
+
+// Get the markdown for the Rust type of a type id: its path if it has one, or else its structure.
+// The name a field is declared with is shown too, if it's different.
+func (dg *DocGenerator) rustType(id int64, typeName string) string {
+	// Anonymous types are named by their structure, down to a limited depth
+	desc := utils.RustTypeName(dg.lookup, id, 4)
+	if typeName != "" && typeName != desc {
+		return fmt.Sprintf("`%v` (`%v`)", typeName, desc)
+	}
+	return "`" + desc + "`"
+}
+
+// Write the docs from the metadata as a paragraph, if there are any
+func writeDocs(b *strings.Builder, docs []types.Text) {
+	lines := []string{}
+	for _, d := range docs {
+		lines = append(lines, strings.TrimPrefix(string(d), " "))
+	}
+	if text := strings.TrimSpace(strings.Join(lines, "\n")); text != "" {
+		fmt.Fprintf(b, "%v\n\n", text)
+	}
+}
+
+// Get the docs from the metadata on one line, to fit a table cell
+func cell(docs []types.Text) string {
+	parts := []string{}
+	for _, d := range docs {
+		if s := strings.TrimSpace(string(d)); s != "" {
+			parts = append(parts, strings.ReplaceAll(s, "|", "\\|"))
+		}
+	}
+	return strings.Join(parts, " ")
+}
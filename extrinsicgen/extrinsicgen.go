@@ -0,0 +1,179 @@
+package extrinsicgen
+
+import (
+	"fmt"
+
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/dave/jennifer/jen"
+)
+
+// The extrinsic generator generates a builder which assembles, signs and encodes extrinsics using
+// exactly the signed extensions listed in the runtime's metadata, and estimates their fees. The
+// types it uses are generated by the TypeGenerator.
+type ExtrinsicGenerator struct {
+	F     *jen.File
+	meta  *types.MetadataV14
+	tygen *typegen.TypeGenerator
+}
+
+func NewExtrinsicGenerator(pkgPath string, meta *types.MetadataV14, tygen *typegen.TypeGenerator) ExtrinsicGenerator {
+	F := jen.NewFilePath(pkgPath)
+	return ExtrinsicGenerator{F: F, meta: meta, tygen: tygen}
+}
+
+// Generate the signer interface and the extrinsic builder, and return the file as a string
+func (eg *ExtrinsicGenerator) Generate() (string, error) {
+	extGend, err := eg.tygen.GetExtrinsicType(&eg.meta.Extrinsic)
+	if err != nil {
+		return "", err
+	}
+	callGend, err := eg.tygen.GetCallType()
+	if err != nil {
+		return "", err
+	}
+
+	eg.generateSigner(extGend)
+	eg.generateBuilder(extGend, callGend)
+	if err := eg.generateFeeEstimation(extGend, callGend); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%#v", eg.F), nil
+}
+
+// Generate the interface used to sign extrinsics. This lets the builder work with any key
+// management, such as a keyring, a hardware wallet or a remote signing service.
+//
+// example output:
+//
+//	// Signs extrinsics for an account
+//	type Signer interface {
+//		// The address of the account, as included in signed extrinsics
+//		Address() types1.MultiAddress
+//		// Sign a payload
+//		Sign(payload []byte) (types1.MultiSignature, error)
+//	}
+func (eg *ExtrinsicGenerator) generateSigner(extGend *typegen.ExtrinsicGend) {
+	eg.F.Comment("Signs extrinsics for an account")
+	eg.F.Type().Id("Signer").Interface(
+		jen.Comment("The address of the account, as included in signed extrinsics"),
+		jen.Id("Address").Params().Custom(utils.TypeOpts, extGend.Address.Code()),
+		jen.Comment("Sign a payload"),
+		jen.Id("Sign").Params(jen.Id("payload").Index().Byte()).Params(jen.Custom(utils.TypeOpts, extGend.Signature.Code()), jen.Error()),
+	)
+}
+
+// Generate the builder for signed extrinsics.
+//
+// example output (shortened):
+//
+//	// Builds signed extrinsics for the runtime. Every signed extension's extra and additional
+//	// signed data must be filled in before building
+//	type Builder struct {
+//		Call             types1.RuntimeCall
+//		Extra            types1.ExtrinsicExtra
+//		AdditionalSigned types1.ExtrinsicAdditionalSigned
+//	}
+//
+//	func NewBuilder(call types1.RuntimeCall) *Builder {
+//		return &Builder{Call: call}
+//	}
+//
+//	func (b *Builder) SigningPayload() (payload []byte, err error) {...}
+//
+//	func (b *Builder) Build(signer Signer) (ret types1.Extrinsic, err error) {...}
+//
+//	func (b *Builder) BuildEncoded(signer Signer) ([]byte, error) {...}
+func (eg *ExtrinsicGenerator) generateBuilder(extGend *typegen.ExtrinsicGend, callGend *typegen.VariantGend) {
+	eg.F.Comment("Builds signed extrinsics for the runtime. Every signed extension's extra and additional")
+	eg.F.Comment("signed data must be filled in before building")
+	eg.F.Type().Id("Builder").Struct(
+		jen.Id("Call").Custom(utils.TypeOpts, callGend.Code()),
+		jen.Id("Extra").Custom(utils.TypeOpts, extGend.Extra.Code()),
+		jen.Id("AdditionalSigned").Custom(utils.TypeOpts, extGend.AdditionalSigned.Code()),
+	)
+
+	eg.F.Comment("Create a builder for an extrinsic making the given call")
+	eg.F.Func().Id("NewBuilder").Params(jen.Id("call").Custom(utils.TypeOpts, callGend.Code())).Op("*").Id("Builder").Block(
+		jen.Return(jen.Op("&").Id("Builder").Values(jen.Dict{jen.Id("Call"): jen.Id("call")})),
+	)
+
+	// output:
+	// func (b *Builder) SigningPayload() (payload []byte, err error) {
+	//   var buf bytes.Buffer
+	//   encoder := scale.NewEncoder(&buf)
+	//   err = encoder.Encode(b.Call)
+	//   ...
+	//   payload = buf.Bytes()
+	//   if len(payload) > 256 {
+	//     payload, err = blake2b256(payload)
+	//   }
+	//   return
+	// }
+	eg.F.Comment("Get the payload the signer signs: the call, followed by the extra and additional signed data")
+	eg.F.Comment("of each signed extension. Payloads longer than 256 bytes are hashed with blake2-256.")
+	eg.F.Func().Params(jen.Id("b").Op("*").Id("Builder")).Id("SigningPayload").Params().Params(
+		jen.Id("payload").Index().Byte(), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		g.Var().Id("buf").Qual("bytes", "Buffer")
+		g.Id("encoder").Op(":=").Qual(typegen.SCALE, "NewEncoder").Call(jen.Op("&").Id("buf"))
+		for _, f := range []string{"Call", "Extra", "AdditionalSigned"} {
+			g.Err().Op("=").Id("encoder").Dot("Encode").Call(jen.Id("b").Dot(f))
+			utils.ErrorCheckWithNamedArgs(g)
+		}
+		g.Id("payload").Op("=").Id("buf").Dot("Bytes").Call()
+		g.If(jen.Len(jen.Id("payload")).Op(">").Lit(256)).Block(
+			jen.List(jen.Id("payload"), jen.Err()).Op("=").Id("blake2b256").Call(jen.Id("payload")),
+		)
+		g.Return()
+	})
+
+	// output:
+	// func (b *Builder) Build(signer Signer) (ret types1.Extrinsic, err error) {
+	//   payload, err := b.SigningPayload()
+	//   if err != nil {
+	//     return
+	//   }
+	//   sig, err := signer.Sign(payload)
+	//   if err != nil {
+	//     return
+	//   }
+	//   ret = types1.Extrinsic{...}
+	//   return
+	// }
+	eg.F.Comment("Sign the extrinsic with the signer")
+	eg.F.Func().Params(jen.Id("b").Op("*").Id("Builder")).Id("Build").Params(jen.Id("signer").Id("Signer")).Params(
+		jen.Id("ret").Custom(utils.TypeOpts, extGend.Code()), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		g.List(jen.Id("payload"), jen.Err()).Op(":=").Id("b").Dot("SigningPayload").Call()
+		utils.ErrorCheckWithNamedArgs(g)
+		g.List(jen.Id("sig"), jen.Err()).Op(":=").Id("signer").Dot("Sign").Call(jen.Id("payload"))
+		utils.ErrorCheckWithNamedArgs(g)
+		g.Id("ret").Op("=").Custom(utils.TypeOpts, extGend.Code()).Values(jen.Dict{
+			jen.Id("IsSigned"):  jen.True(),
+			jen.Id("Address"):   jen.Id("signer").Dot("Address").Call(),
+			jen.Id("Signature"): jen.Id("sig"),
+			jen.Id("Extra"):     jen.Id("b").Dot("Extra"),
+			jen.Id("Call"):      jen.Id("b").Dot("Call"),
+		})
+		g.Return()
+	})
+
+	eg.F.Comment("Sign the extrinsic with the signer and SCALE-encode it, ready to be submitted with `author_submitExtrinsic`")
+	eg.F.Func().Params(jen.Id("b").Op("*").Id("Builder")).Id("BuildEncoded").Params(jen.Id("signer").Id("Signer")).Params(
+		jen.Index().Byte(), jen.Error(),
+	).BlockFunc(func(g *jen.Group) {
+		g.List(jen.Id("ext"), jen.Err()).Op(":=").Id("b").Dot("Build").Call(jen.Id("signer"))
+		utils.ErrorCheckWithNil(g)
+		g.Return(jen.Qual(utils.CCODEC, "Encode").Call(jen.Id("ext")))
+	})
+
+	// func blake2b256(data []byte) ([]byte, error) {...}
+	eg.F.Func().Id("blake2b256").Params(jen.Id("data").Index().Byte()).Params(jen.Index().Byte(), jen.Error()).BlockFunc(func(g *jen.Group) {
+		g.List(jen.Id("h"), jen.Err()).Op(":=").Qual(utils.GSRPCHash, "NewBlake2b256").Call(jen.Nil())
+		utils.ErrorCheckWithNil(g)
+		g.Id("h").Dot("Write").Call(jen.Id("data"))
+		g.Return(jen.Id("h").Dot("Sum").Call(jen.Nil()), jen.Nil())
+	})
+}
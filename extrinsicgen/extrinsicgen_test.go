@@ -0,0 +1,144 @@
+package extrinsicgen
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/internal/testmeta"
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/require"
+)
+
+// The fee estimation test run in the generated module. The stub node answers the query info runtime
+// API with a weight of 1000, the normal dispatch class and a fee of 12345.
+const feeTest = `package extrinsic_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"example.com/gen/extrinsic"
+	"example.com/gen/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/client"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+type signer struct{}
+
+func (signer) Address() types.MultiAddress {
+	return types.MultiAddress{IsId: true, AsIdField0: [32]byte{1}}
+}
+
+func (signer) Sign(payload []byte) (types.MultiSignature, error) {
+	panic("fee estimation must not sign")
+}
+
+func TestEstimateFee(t *testing.T) {
+	var params []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage ` + "`json:\"id\"`" + `
+			Method string          ` + "`json:\"method\"`" + `
+			Params []string        ` + "`json:\"params\"`" + `
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Method != "state_call" {
+			t.Errorf("unexpected request %v %v", req.Method, err)
+		}
+		params = req.Params
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"result":  "0xe80300000000000000393000000000000000000000000000000000",
+		})
+	}))
+	defer srv.Close()
+
+	c, err := client.Connect(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// System.remark with an empty remark
+	var call types.RuntimeCall
+	if err := codec.Decode([]byte{0, 1, 0}, &call); err != nil {
+		t.Fatal(err)
+	}
+
+	fee, err := extrinsic.EstimateFee(c, call, signer{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee.Weight != 1000 || !fee.Class.IsNormal || fee.PartialFee.Int64() != 12345 {
+		t.Fatalf("unexpected fee %+v", fee)
+	}
+
+	if len(params) != 2 || params[0] != "TransactionPaymentApi_query_info" {
+		t.Fatalf("unexpected params %v", params)
+	}
+	arg, err := codec.HexDecodeString(params[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, encodedLen := arg[:len(arg)-4], binary.LittleEndian.Uint32(arg[len(arg)-4:])
+	if int(encodedLen) != len(encoded) {
+		t.Fatalf("got length %v for an extrinsic of %v bytes", encodedLen, len(encoded))
+	}
+	ext, err := types.DecodeExtrinsic(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ext.IsSigned || ext.Address.AsIdField0[0] != 1 || ext.Call.CallName() != "remark" {
+		t.Fatalf("unexpected extrinsic %+v", ext)
+	}
+}
+`
+
+// Generate the types and extrinsic packages into a temporary module, and run the fee estimation
+// test against a stub node
+func TestEstimateFeeStubNode(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds generated code")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not found")
+	}
+
+	meta := testmeta.Example(t)
+	encMeta, err := codec.EncodeToHex(types.Metadata{MagicNumber: types.MagicNumber, Version: 14, AsMetadataV14: *meta})
+	require.NoError(t, err)
+	tg := typegen.NewTypeGenerator(meta, encMeta, "example.com/gen/types")
+	tg.GenerateBackend()
+	eg := NewExtrinsicGenerator("example.com/gen/extrinsic", meta, &tg)
+	extrinsic, err := eg.Generate()
+	require.NoError(t, err)
+	require.NoError(t, tg.GenerateCallHelpers())
+
+	dir := t.TempDir()
+	goSum, err := os.ReadFile("../go.sum")
+	require.NoError(t, err)
+	files := map[string]string{
+		"go.mod":                 "module example.com/gen\n\ngo 1.18\n\nrequire github.com/centrifuge/go-substrate-rpc-client/v4 v4.0.7\n",
+		"go.sum":                 string(goSum),
+		"types/types.go":         tg.GetGenerated(),
+		"extrinsic/extrinsic.go": extrinsic,
+		"extrinsic/fee_test.go":  feeTest,
+	}
+	for name, content := range files {
+		fp := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(fp), os.ModePerm))
+		require.NoError(t, os.WriteFile(fp, []byte(content), 0644))
+	}
+
+	cmd := exec.Command(goBin, "test", "./extrinsic/")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
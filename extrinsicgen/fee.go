@@ -0,0 +1,208 @@
+package extrinsicgen
+
+import (
+	"fmt"
+
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/dave/jennifer/jen"
+)
+
+// The runtime API used to estimate fees
+const queryInfoApi = "TransactionPaymentApi_query_info"
+
+// Generate fee estimation for extrinsics, using the TransactionPaymentApi_query_info runtime API.
+// Its result is decoded into the weight and dispatch class types of the runtime's DispatchInfo, and
+// the balance type of the Balances pallet. If the runtime has no DispatchInfo type, no fee
+// estimation is generated.
+//
+// example output (shortened):
+//
+//	type FeeInfo struct {
+//		Weight     uint64
+//		Class      types1.DispatchClass
+//		PartialFee types.U128
+//	}
+//
+//	func EstimateFee(c client.Client, call types1.RuntimeCall, signer Signer) (FeeInfo, error) {
+//		b := NewBuilder(call)
+//		b.Extra = types1.ExtrinsicExtra{CheckMortality: types1.Era{IsImmortal: true}, ...}
+//		return b.EstimateFee(c, signer)
+//	}
+//
+//	func (b *Builder) EstimateFee(c client.Client, signer Signer) (ret FeeInfo, err error) {
+//		ext := types1.Extrinsic{
+//			IsSigned:  true,
+//			Address:   signer.Address(),
+//			Signature: types1.MultiSignature{IsEd25519: true},
+//			Extra:     b.Extra,
+//			Call:      b.Call,
+//		}
+//		encoded, err := codec.Encode(ext)
+//		if err != nil {
+//			return
+//		}
+//		encodedLen, err := codec.Encode(uint32(len(encoded)))
+//		if err != nil {
+//			return
+//		}
+//		var res string
+//		err = c.Call(&res, "state_call", "TransactionPaymentApi_query_info", codec.HexEncodeToString(append(encoded, encodedLen...)))
+//		if err != nil {
+//			return
+//		}
+//		err = codec.DecodeFromHex(res, &ret)
+//		return
+//	}
+func (eg *ExtrinsicGenerator) generateFeeEstimation(extGend *typegen.ExtrinsicGend, callGend *typegen.VariantGend) error {
+	weight, class, err := eg.dispatchInfoTypes()
+	if err != nil {
+		return err
+	}
+	if weight == nil || class == nil {
+		eg.tygen.Warn("no DispatchInfo type found, not generating fee estimation")
+		return nil
+	}
+	balance, err := eg.balanceCode()
+	if err != nil {
+		return err
+	}
+
+	eg.F.Comment(fmt.Sprintf("The fee of an extrinsic, as returned by the %v runtime API", queryInfoApi))
+	eg.F.Type().Id("FeeInfo").Struct(
+		jen.Comment("The weight of the extrinsic"),
+		jen.Id("Weight").Custom(utils.TypeOpts, weight.Code()),
+		jen.Comment("The dispatch class of the extrinsic"),
+		jen.Id("Class").Custom(utils.TypeOpts, class.Code()),
+		jen.Comment("The fee, excluding the tip and any adjustments made after dispatch"),
+		jen.Id("PartialFee").Add(balance),
+	)
+
+	// The node client, and the arguments to the estimation functions which take it
+	clientArgs := []jen.Code{jen.Id("c").Qual(utils.GSRPCClient, "Client")}
+	clientCall := jen.Id("c").Dot("Call")
+	callArgs := []jen.Code{}
+	if eg.tygen.WithContext {
+		clientArgs = []jen.Code{jen.Id("ctx").Qual("context", "Context"), jen.Id("c").Add(eg.tygen.BackendCode("ContextCaller"))}
+		clientCall = jen.Id("c").Dot("CallContext")
+		callArgs = append(callArgs, jen.Id("ctx"))
+	}
+	clientArgNames := []jen.Code{jen.Id("c")}
+	if eg.tygen.WithContext {
+		clientArgNames = []jen.Code{jen.Id("ctx"), jen.Id("c")}
+	}
+
+	extra, err := eg.defaultExtra(extGend)
+	if err != nil {
+		return err
+	}
+	eg.F.Comment("Estimate the fee of an extrinsic making the call, signed by the signer. The signed extensions'")
+	eg.F.Comment("extra data is set to defaults, use Builder.EstimateFee to estimate the fee with other extra data")
+	eg.F.Func().Id("EstimateFee").Params(
+		append(clientArgs, jen.Id("call").Custom(utils.TypeOpts, callGend.Code()), jen.Id("signer").Id("Signer"))...,
+	).Params(jen.Id("FeeInfo"), jen.Error()).Block(
+		jen.Id("b").Op(":=").Id("NewBuilder").Call(jen.Id("call")),
+		jen.Id("b").Dot("Extra").Op("=").Add(extra),
+		jen.Return(jen.Id("b").Dot("EstimateFee").Call(append(clientArgNames, jen.Id("signer"))...)),
+	)
+
+	signature, err := eg.tygen.DefaultValue(extGend.Signature)
+	if err != nil {
+		return err
+	}
+	if signature == nil {
+		signature = jen.Custom(utils.TypeOpts, extGend.Signature.Code()).Values()
+	}
+	eg.F.Comment("Estimate the fee of the extrinsic. It's given a fake signature, so the signer is only used for")
+	eg.F.Comment("its address")
+	eg.F.Func().Params(jen.Id("b").Op("*").Id("Builder")).Id("EstimateFee").Params(
+		append(clientArgs, jen.Id("signer").Id("Signer"))...,
+	).Params(jen.Id("ret").Id("FeeInfo"), jen.Err().Error()).BlockFunc(func(g *jen.Group) {
+		g.Id("ext").Op(":=").Custom(utils.TypeOpts, extGend.Code()).Values(
+			jen.Line().Id("IsSigned").Op(":").True(),
+			jen.Line().Id("Address").Op(":").Id("signer").Dot("Address").Call(),
+			jen.Line().Id("Signature").Op(":").Add(signature),
+			jen.Line().Id("Extra").Op(":").Id("b").Dot("Extra"),
+			jen.Line().Id("Call").Op(":").Id("b").Dot("Call"),
+			jen.Line(),
+		)
+		g.List(jen.Id("encoded"), jen.Err()).Op(":=").Qual(utils.CCODEC, "Encode").Call(jen.Id("ext"))
+		utils.ErrorCheckWithNamedArgs(g)
+		// The runtime API takes the extrinsic and its encoded length
+		g.List(jen.Id("encodedLen"), jen.Err()).Op(":=").Qual(utils.CCODEC, "Encode").Call(jen.Uint32().Call(jen.Len(jen.Id("encoded"))))
+		utils.ErrorCheckWithNamedArgs(g)
+		g.Var().Id("res").String()
+		g.Err().Op("=").Add(clientCall).Call(append(callArgs,
+			jen.Op("&").Id("res"),
+			jen.Lit("state_call"),
+			jen.Lit(queryInfoApi),
+			jen.Qual(utils.CCODEC, "HexEncodeToString").Call(jen.Append(jen.Id("encoded"), jen.Id("encodedLen").Op("..."))),
+		)...)
+		utils.ErrorCheckWithNamedArgs(g)
+		g.Err().Op("=").Qual(utils.CCODEC, "DecodeFromHex").Call(jen.Id("res"), jen.Op("&").Id("ret"))
+		g.Return()
+	})
+	return nil
+}
+
+// Get the weight and dispatch class types from the runtime's DispatchInfo type
+func (eg *ExtrinsicGenerator) dispatchInfoTypes() (weight, class typegen.GeneratedType, err error) {
+	for _, mt := range eg.meta.Lookup.Types {
+		path := mt.Type.Path
+		if len(path) == 0 || path[len(path)-1] != "DispatchInfo" || !mt.Type.Def.IsComposite {
+			continue
+		}
+		for _, f := range mt.Type.Def.Composite.Fields {
+			switch f.Name {
+			case "weight":
+				weight, err = eg.tygen.GetType(f.Type.Int64())
+			case "class":
+				class, err = eg.tygen.GetType(f.Type.Int64())
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		return weight, class, nil
+	}
+	return nil, nil, nil
+}
+
+// Get the code for the runtime's balance type, which is the type of the Balances pallet's
+// ExistentialDeposit constant, or a U128 if there is none
+func (eg *ExtrinsicGenerator) balanceCode() (*jen.Statement, error) {
+	for _, pallet := range eg.meta.Pallets {
+		if pallet.Name != "Balances" {
+			continue
+		}
+		for _, c := range pallet.Constants {
+			if c.Name == "ExistentialDeposit" {
+				gend, err := eg.tygen.GetType(c.Type.Int64())
+				if err != nil {
+					return nil, err
+				}
+				return jen.Custom(utils.TypeOpts, gend.Code()), nil
+			}
+		}
+	}
+	return jen.Qual(utils.CTYPES, "U128"), nil
+}
+
+// Get the default extra data of every signed extension, which can be encoded
+func (eg *ExtrinsicGenerator) defaultExtra(extGend *typegen.ExtrinsicGend) (*jen.Statement, error) {
+	values := jen.Dict{}
+	for i, se := range eg.meta.Extrinsic.SignedExtensions {
+		gend, err := eg.tygen.GetType(se.Type.Int64())
+		if err != nil {
+			return nil, err
+		}
+		value, err := eg.tygen.DefaultValue(gend)
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			values[jen.Id(extGend.Extra.Fields[i].Name)] = value
+		}
+	}
+	return jen.Custom(utils.TypeOpts, extGend.Extra.Code()).Values(values), nil
+}
@@ -0,0 +1,304 @@
+// Package gen generates the code for a runtime's metadata in memory, so the generator can be
+// embedded in other tools. Files are returned by their slash-separated path relative to the
+// generated package, and can be written anywhere through a Sink.
+package gen
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aphoh/go-substrate-gen/chaintestgen"
+	"github.com/aphoh/go-substrate-gen/dispatchgen"
+	"github.com/aphoh/go-substrate-gen/docgen"
+	"github.com/aphoh/go-substrate-gen/extrinsicgen"
+	"github.com/aphoh/go-substrate-gen/palletgen"
+	"github.com/aphoh/go-substrate-gen/protogen"
+	"github.com/aphoh/go-substrate-gen/schemagen"
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Options for generating code
+type Options struct {
+	// The fully qualified package path the code is generated into, e.g. github.com/my/package/chain
+	PkgPath string
+	// Whether the generated functions which talk to a node take a context.Context as their first
+	// argument
+	WithContext bool
+	// Whether to generate types/types_test.go, with round-trip tests and fuzz targets of every
+	// generated struct, variant and tuple
+	WithTests bool
+	// Whether to generate chaintest/chaintest.go, an in-memory fake of a node's storage, with a
+	// Set{Pallet}{Item} function seeding each storage item
+	WithChainTest bool
+	// The slash-separated directory to write a Markdown reference of the generated API into,
+	// relative to the generated package, or empty for none
+	DocsDir string
+	// Whether to generate typespb/types.proto, protobuf messages of every generated struct, variant
+	// and tuple, and protoconv/protoconv.go, converting the types to and from the messages protoc
+	// generates into typespb
+	WithProto bool
+	// Where warnings about code left ungenerated by problems with the metadata are written, one per
+	// line, or nowhere if nil
+	Warnings io.Writer
+}
+
+// The metadata of one runtime version, for GenerateVersions
+type Version struct {
+	SpecVersion uint32
+	Meta        *types.MetadataV14
+}
+
+// Generate the code for a metadata. The files are laid out as:
+//
+//	types/types.go
+//	$PALLET/storage.go
+//	$PALLET/calls.go
+//	extrinsic/extrinsic.go
+//	types/types_test.go, with WithTests
+//	chaintest/chaintest.go, with WithChainTest
+//	$DOCS_DIR/index.md, $DOCS_DIR/$PALLET.md and $DOCS_DIR/types.md, with DocsDir
+//	typespb/types.proto and protoconv/protoconv.go, with WithProto
+func Generate(meta *types.MetadataV14, opts Options) (map[string][]byte, error) {
+	files, tg, err := generate(meta, opts.PkgPath, "", opts, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := renderTypes(files, "", tg, opts); err != nil {
+		return nil, err
+	}
+	if err := renderProto(files, "", opts.PkgPath, tg, opts); err != nil {
+		return nil, err
+	}
+	renderDocs(files, opts.DocsDir, meta, opts.PkgPath, tg, opts)
+	return files, nil
+}
+
+// Generate the code for several runtime versions, each into a v{spec version} directory laid out
+// like Generate's files, and a dispatch package picking the version for a block, in
+// dispatch/dispatch.go. Types which are identical in several versions are defined by the earliest
+// one, and aliased by the others. The docs of each version are written into a v{spec version}
+// directory of DocsDir.
+func GenerateVersions(versions []Version, opts Options) (map[string][]byte, error) {
+	versions = append([]Version{}, versions...)
+	// Earlier versions define the shared types
+	sort.Slice(versions, func(i, j int) bool { return versions[i].SpecVersion < versions[j].SpecVersion })
+
+	shared := typegen.NewSharedTypes()
+	dg := dispatchgen.NewDispatchGenerator(path.Join(opts.PkgPath, "/dispatch"))
+	files := map[string][]byte{}
+	tgs := map[string]*typegen.TypeGenerator{}
+	metas := map[string]*types.MetadataV14{}
+	for _, v := range versions {
+		name := fmt.Sprintf("v%v", v.SpecVersion)
+		vFiles, tg, err := generate(v.Meta, path.Join(opts.PkgPath, "/"+name), name, opts, shared)
+		if err != nil {
+			return nil, fmt.Errorf("spec version %v: %v", v.SpecVersion, err)
+		}
+		for p, content := range vFiles {
+			files[p] = content
+		}
+		tgs[name] = tg
+		metas[name] = v.Meta
+		dg.AddVersion(v.SpecVersion, v.Meta, tg)
+	}
+
+	dispatch, err := dg.Generate()
+	if err != nil {
+		return nil, fmt.Errorf("error generating dispatcher: %v", err)
+	}
+	files["dispatch/dispatch.go"] = []byte(dispatch)
+
+	// The dispatcher may use more types, so they're rendered last
+	for name, tg := range tgs {
+		if err := renderTypes(files, name, tg, opts); err != nil {
+			return nil, fmt.Errorf("%v: %v", name, err)
+		}
+		if err := renderProto(files, name, path.Join(opts.PkgPath, name), tg, opts); err != nil {
+			return nil, fmt.Errorf("%v: %v", name, err)
+		}
+		renderDocs(files, path.Join(opts.DocsDir, name), metas[name], path.Join(opts.PkgPath, name), tg, opts)
+	}
+	return files, nil
+}
+
+// Generate a JSON Schema of the JSON encoding of the types generated for a metadata, or an OpenAPI
+// document with the schemas as its components with `openAPI`. Nothing else is rendered.
+func Schema(meta *types.MetadataV14, opts Options, openAPI bool) ([]byte, error) {
+	_, tg, err := generate(meta, opts.PkgPath, "", opts, nil)
+	if err != nil {
+		return nil, err
+	}
+	sg := schemagen.NewSchemaGenerator(meta, tg)
+	if openAPI {
+		return sg.OpenAPI()
+	}
+	return sg.JSONSchema()
+}
+
+// Render the types of `dir`, once nothing else will be generated, and their tests with WithTests
+func renderTypes(files map[string][]byte, dir string, tg *typegen.TypeGenerator, opts Options) error {
+	if opts.WithTests {
+		tests, err := tg.GenerateRoundTripTests()
+		if err != nil {
+			return fmt.Errorf("error generating round-trip tests: %v", err)
+		}
+		files[path.Join(dir, "types/types_test.go")] = []byte(tests)
+	}
+	files[path.Join(dir, "types/types.go")] = []byte(tg.GetGenerated())
+	return nil
+}
+
+// Render the protobuf messages of the types of `dir`, and their converters, with WithProto. Like the
+// types, this must be done once nothing else will be generated.
+func renderProto(files map[string][]byte, dir string, pkgPath string, tg *typegen.TypeGenerator, opts Options) error {
+	if !opts.WithProto {
+		return nil
+	}
+	pg := protogen.NewProtoGenerator(pkgPath, tg)
+	proto, conv, err := pg.Generate()
+	if err != nil {
+		return fmt.Errorf("error generating protobuf messages: %v", err)
+	}
+	files[path.Join(dir, protogen.ProtoFile)] = []byte(proto)
+	files[path.Join(dir, "protoconv/protoconv.go")] = []byte(conv)
+	return nil
+}
+
+// Render the docs of the code generated for a metadata into `dir`, if DocsDir is set. This must be
+// done last, since the docs only refer to types the code uses.
+func renderDocs(files map[string][]byte, dir string, meta *types.MetadataV14, pkgPath string, tg *typegen.TypeGenerator, opts Options) {
+	if opts.DocsDir == "" {
+		return
+	}
+	dg := docgen.NewDocGenerator(meta, pkgPath, tg)
+	for name, content := range dg.Generate() {
+		files[path.Join(dir, name)] = content
+	}
+}
+
+// Generate the code for one metadata into `dir`, whose package path is `pkgPath` rather than
+// opts.PkgPath, except for the types, which are only complete once all of the code using them is
+// generated
+func generate(meta *types.MetadataV14, pkgPath string, dir string, opts Options, shared *typegen.SharedTypes) (map[string][]byte, *typegen.TypeGenerator, error) {
+	// The types embed the metadata, to make storage keys
+	encMeta, err := codec.EncodeToHex(types.Metadata{
+		MagicNumber:   types.MagicNumber,
+		Version:       14,
+		AsMetadataV14: *meta,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error encoding metadata: %v", err)
+	}
+
+	files := map[string][]byte{}
+	tg := typegen.NewTypeGenerator(meta, encMeta, path.Join(pkgPath, "/types"))
+	tg.WithContext = opts.WithContext
+	tg.Shared = shared
+	tg.GenerateBackend()
+
+	for _, pallet := range meta.Pallets {
+		lowerName := strings.ToLower(string(pallet.Name))
+		palletPath := path.Join(pkgPath, "/"+lowerName)
+		pg := palletgen.NewPalletGenerator(&pallet, &tg)
+
+		storage, isSome, err := pg.GenerateStorage(palletPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating storage for pallet %v: %v", pallet.Name, err)
+		}
+		if isSome {
+			files[path.Join(dir, lowerName, "storage.go")] = []byte(storage)
+		}
+
+		calls, isSome, err := pg.GenerateCalls(palletPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating calls for pallet %v: %v", pallet.Name, err)
+		}
+		if isSome {
+			files[path.Join(dir, lowerName, "calls.go")] = []byte(calls)
+		}
+	}
+
+	// The extrinsic builder, which uses the runtime's signed extensions
+	extGen := extrinsicgen.NewExtrinsicGenerator(path.Join(pkgPath, "/extrinsic"), meta, &tg)
+	extrinsic, err := extGen.Generate()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating extrinsic builder: %v", err)
+	}
+	files[path.Join(dir, "extrinsic", "extrinsic.go")] = []byte(extrinsic)
+
+	if opts.WithChainTest {
+		ctGen := chaintestgen.NewChainTestGenerator(path.Join(pkgPath, "/chaintest"), meta, &tg)
+		chaintest, err := ctGen.Generate()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error generating chaintest: %v", err)
+		}
+		files[path.Join(dir, "chaintest", "chaintest.go")] = []byte(chaintest)
+	}
+
+	err = tg.GenerateCallHelpers()
+	if err != nil {
+		return nil, nil, err
+	}
+	err = tg.GenerateCompatibility(meta)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error generating metadata hashes: %v", err)
+	}
+	if opts.Warnings != nil {
+		for _, w := range tg.Warnings {
+			if dir != "" {
+				w = dir + ": " + w
+			}
+			fmt.Fprintf(opts.Warnings, "Warning: %v\n", w)
+		}
+	}
+	return files, &tg, nil
+}
+
+// A destination for generated files, like a directory, an archive or a test's memory
+type Sink interface {
+	// Write a file, given its slash-separated path
+	WriteFile(name string, data []byte) error
+}
+
+// Write the files to a sink, in order of path
+func Write(files map[string][]byte, sink Sink) error {
+	for _, name := range SortedPaths(files) {
+		if err := sink.WriteFile(name, files[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get the paths of the files in order
+func SortedPaths(files map[string][]byte) []string {
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// A sink writing files into a directory, creating subdirectories as needed
+type DirSink string
+
+func (d DirSink) WriteFile(name string, data []byte) error {
+	fp := filepath.Join(string(d), filepath.FromSlash(name))
+	err := os.MkdirAll(filepath.Dir(fp), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("error creating %v path: %v", filepath.Dir(fp), err)
+	}
+	err = os.WriteFile(fp, data, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing %v: %v", fp, err)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/internal/testmeta"
+	"github.com/aphoh/go-substrate-gen/metadata/builder"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Collects written files in memory
+type mapSink map[string][]byte
+
+func (m mapSink) WriteFile(name string, data []byte) error {
+	m[name] = data
+	return nil
+}
+
+func TestGenerate(t *testing.T) {
+	opts := Options{PkgPath: "example.com/chain"}
+	files, err := Generate(testmeta.Example(t), opts)
+	require.NoError(t, err)
+	for _, name := range []string{"types/types.go", "balances/calls.go", "balances/storage.go", "extrinsic/extrinsic.go"} {
+		require.Contains(t, files, name)
+	}
+
+	// Generation is deterministic
+	again, err := Generate(testmeta.Example(t), opts)
+	require.NoError(t, err)
+	require.Equal(t, files, again)
+
+	sink := mapSink{}
+	require.NoError(t, Write(files, sink))
+	require.Equal(t, mapSink(files), sink)
+
+	files, err = GenerateVersions([]Version{{SpecVersion: 2, Meta: testmeta.Example(t)}, {SpecVersion: 1, Meta: testmeta.Example(t)}}, opts)
+	require.NoError(t, err)
+	for _, name := range []string{"v1/types/types.go", "v2/types/types.go", "v2/balances/calls.go", "dispatch/dispatch.go"} {
+		require.Contains(t, files, name)
+	}
+	// The earliest version defines the types
+	require.Contains(t, string(files["v2/types/types.go"]), "type AccountData = types1.AccountData")
+}
+
+// Warnings are written to Options.Warnings, and not mixed into the output
+func TestWarnings(t *testing.T) {
+	b := builder.New("test_runtime")
+	u8 := b.Primitive(types.IsU8)
+	account := b.Composite("sp_core::crypto::AccountId32", builder.F("", b.Array(32, u8)))
+	b.Extrinsic(account, b.Array(64, u8))
+	b.Pallet("Things", 0).Call("bump", builder.F("by", u8))
+	meta, err := b.Build()
+	require.NoError(t, err)
+
+	warnings := &bytes.Buffer{}
+	schema, err := Schema(meta, Options{PkgPath: "example.com/chain", Warnings: warnings}, false)
+	require.NoError(t, err)
+	require.True(t, json.Valid(schema))
+	require.Equal(t, "Warning: no DispatchInfo type found, not generating fee estimation\n", warnings.String())
+
+	// Versions' warnings are prefixed with their directory
+	warnings.Reset()
+	_, err = GenerateVersions([]Version{{SpecVersion: 1, Meta: meta}}, Options{PkgPath: "example.com/chain", Warnings: warnings})
+	require.NoError(t, err)
+	require.Equal(t, "Warning: v1: no DispatchInfo type found, not generating fee estimation\n", warnings.String())
+}
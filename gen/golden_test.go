@@ -0,0 +1,279 @@
+package gen
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/internal/testmeta"
+	"github.com/aphoh/go-substrate-gen/metadata"
+	"github.com/aphoh/go-substrate-gen/protogen"
+	"github.com/aphoh/go-substrate-gen/textdiff"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata/golden")
+
+// The fixtures in ../testdata/fixtures, which are written by ../testdata/mkfixtures
+var fixtures = []string{"minimal", "kinds", "wrappers"}
+
+func loadFixture(t *testing.T, name string) *types.MetadataV14 {
+	inp, err := os.ReadFile(filepath.Join("..", "testdata", "fixtures", name+".json"))
+	require.NoError(t, err)
+	meta, _, err := metadata.ParseMetadata(inp)
+	require.NoError(t, err)
+	return meta
+}
+
+// A fixture generated with some options, whose golden files are in testdata/golden/$NAME
+type goldenCase struct {
+	name    string
+	fixture string
+	opts    Options
+}
+
+// Every fixture with every option, and the minimal fixture with context support
+func goldenCases() []goldenCase {
+	cases := []goldenCase{}
+	for _, fixture := range fixtures {
+		cases = append(cases, goldenCase{fixture, fixture, Options{PkgPath: "example.com/" + fixture, WithTests: true, WithChainTest: true, DocsDir: "docs", WithProto: true}})
+	}
+	return append(cases, goldenCase{"minimal-ctx", "minimal", Options{PkgPath: "example.com/minimal", WithContext: true, WithTests: true, WithChainTest: true}})
+}
+
+// The generated code of each golden case is compared with testdata/golden/$NAME. After changing the
+// generator, review the changes and update the golden files with
+//
+//	go test ./gen -update
+func TestGolden(t *testing.T) {
+	for _, c := range goldenCases() {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			files, err := Generate(loadFixture(t, c.fixture), c.opts)
+			require.NoError(t, err)
+			dir := filepath.Join("testdata", "golden", c.name)
+
+			if *update {
+				require.NoError(t, os.RemoveAll(dir))
+				golden := map[string][]byte{}
+				for p, content := range files {
+					golden[p+".golden"] = content
+				}
+				require.NoError(t, Write(golden, DirSink(dir)))
+				return
+			}
+
+			existing := map[string]bool{}
+			err = filepath.WalkDir(dir, func(fp string, d fs.DirEntry, err error) error {
+				if err != nil || d.IsDir() {
+					return err
+				}
+				rel, err := filepath.Rel(dir, fp)
+				existing[strings.TrimSuffix(filepath.ToSlash(rel), ".golden")] = true
+				return err
+			})
+			require.NoError(t, err)
+
+			for _, p := range SortedPaths(files) {
+				want, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(p)+".golden"))
+				if os.IsNotExist(err) {
+					t.Errorf("%v has no golden file, run go test ./gen -update", p)
+					continue
+				}
+				require.NoError(t, err)
+				if diff := textdiff.Unified(p+".golden", p, string(want), string(files[p])); diff != "" {
+					t.Errorf("%v differs from its golden file, run go test ./gen -update if this is expected\n%v", p, diff)
+				}
+				delete(existing, p)
+			}
+			for p := range existing {
+				t.Errorf("%v is no longer generated, run go test ./gen -update", p)
+			}
+		})
+	}
+}
+
+// The JSON Schema of each fixture's types is compared with testdata/golden/$FIXTURE.schema.json, and
+// the OpenAPI document has the same schemas
+func TestSchema(t *testing.T) {
+	for _, fixture := range fixtures {
+		t.Run(fixture, func(t *testing.T) {
+			meta := loadFixture(t, fixture)
+			opts := Options{PkgPath: "example.com/" + fixture}
+			schema, err := Schema(meta, opts, false)
+			require.NoError(t, err)
+			golden := filepath.Join("testdata", "golden", fixture+".schema.json")
+			if *update {
+				require.NoError(t, os.WriteFile(golden, append(schema, '\n'), 0644))
+			} else {
+				want, err := os.ReadFile(golden)
+				require.NoError(t, err)
+				if diff := textdiff.Unified(golden, "schema", string(want), string(schema)+"\n"); diff != "" {
+					t.Errorf("the schema differs from its golden file, run go test ./gen -update if this is expected\n%v", diff)
+				}
+			}
+
+			openAPI, err := Schema(meta, opts, true)
+			require.NoError(t, err)
+			var doc struct {
+				Defs map[string]interface{} `json:"$defs"`
+			}
+			require.NoError(t, json.Unmarshal(schema, &doc))
+			var apiDoc struct {
+				Components struct {
+					Schemas map[string]interface{} `json:"schemas"`
+				} `json:"components"`
+			}
+			require.NoError(t, json.Unmarshal(bytes.ReplaceAll(openAPI, []byte("#/components/schemas/"), []byte("#/$defs/")), &apiDoc))
+			require.NotEmpty(t, doc.Defs)
+			require.Equal(t, doc.Defs, apiDoc.Components.Schemas)
+		})
+	}
+}
+
+// The generated code of the fixtures, and of all of them as versions of one runtime, builds and passes vet,
+// and its round-trip tests, the fixture's tests in testdata/usage and testdata/usage/hasher_test.go
+// pass. The usage tests can read the fixture's JSON schema from testdata/schema.json. The fixtures'
+// protobuf converters are built too, with the go code of their messages from protobufCode.
+func TestBuildGenerated(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds modules")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("no go toolchain")
+	}
+	repo, err := filepath.Abs("..")
+	require.NoError(t, err)
+	goSum, err := os.ReadFile(filepath.Join(repo, "go.sum"))
+	require.NoError(t, err)
+	// The sums of the protobuf runtime, which the repo doesn't depend on
+	pbSum, err := os.ReadFile(filepath.Join("testdata", "protobuf", "go.sum"))
+	require.NoError(t, err)
+	goSum = append(goSum, pbSum...)
+
+	// Build the files as a module, vet and test them, then run any other go commands
+	build := func(t *testing.T, pkgPath string, files map[string][]byte, commands ...[]string) {
+		dir := t.TempDir()
+		require.NoError(t, Write(files, DirSink(dir)))
+		goMod := "module " + pkgPath + "\n\ngo 1.18\n\n" +
+			"require (\n\tgithub.com/aphoh/go-substrate-gen v0.0.0\n\tgithub.com/centrifuge/go-substrate-rpc-client/v4 v4.0.7\n" +
+			"\tgoogle.golang.org/protobuf v1.31.0\n)\n\n" +
+			"replace github.com/aphoh/go-substrate-gen => " + repo + "\n"
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "go.sum"), goSum, 0644))
+
+		for _, args := range append([][]string{{"build", "./..."}, {"vet", "./..."}, {"test", "./..."}}, commands...) {
+			cmd := exec.Command("go", args...)
+			cmd.Dir = dir
+			// Everything needed is in the module cache already
+			cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off")
+			out, err := cmd.CombinedOutput()
+			require.NoError(t, err, "go %v:\n%s", strings.Join(args, " "), out)
+		}
+	}
+
+	versions := []Version{}
+	for i, fixture := range fixtures {
+		meta := loadFixture(t, fixture)
+		versions = append(versions, Version{SpecVersion: uint32(100 * (i + 1)), Meta: meta})
+		t.Run(fixture, func(t *testing.T) {
+			pkgPath := "example.com/" + fixture
+			files, err := Generate(meta, Options{PkgPath: pkgPath, WithTests: true, WithChainTest: true, WithProto: true})
+			require.NoError(t, err)
+			files[path.Join("typespb", "types.pb.go")] = protobufCode(t, fixture, files[protogen.ProtoFile])
+			// Tests using the generated code like a user would, if the fixture has any
+			usage, err := os.ReadFile(filepath.Join("testdata", "usage", fixture+"_test.go"))
+			if err == nil {
+				files["usage/usage_test.go"] = usage
+			} else {
+				require.True(t, os.IsNotExist(err), err)
+			}
+			// Tests of the generated hasher against metahash, which it's a copy of
+			hasher, err := os.ReadFile(filepath.Join("testdata", "usage", "hasher_test.go"))
+			require.NoError(t, err)
+			files["types/hasher_test.go"] = hasher
+			// The fixture's JSON schema, to validate the JSON of generated values against
+			schema, err := os.ReadFile(filepath.Join("testdata", "golden", fixture+".schema.json"))
+			require.NoError(t, err)
+			files["usage/testdata/schema.json"] = schema
+			build(t, pkgPath, files)
+		})
+	}
+	t.Run("minimal-ctx", func(t *testing.T) {
+		pkgPath := "example.com/minimal"
+		files, err := Generate(loadFixture(t, "minimal"), Options{PkgPath: pkgPath, WithContext: true, WithTests: true, WithChainTest: true})
+		require.NoError(t, err)
+		usage, err := os.ReadFile(filepath.Join("testdata", "usage", "minimal-ctx_test.go"))
+		require.NoError(t, err)
+		files["usage/usage_test.go"] = usage
+		build(t, pkgPath, files)
+	})
+	// go-substrate-rpc-client's example metadata, of a full node runtime. Its fuzz targets are run
+	// briefly, and on inputs whose length prefixes ran out of memory before they were skipped
+	t.Run("example", func(t *testing.T) {
+		files, err := Generate(testmeta.Example(t), Options{PkgPath: "example.com/example", WithTests: true})
+		require.NoError(t, err)
+		for target, input := range map[string]string{
+			"FuzzDecodeRuntimeCall":  "+\x02\x96\xcd\x10X\x84",
+			"FuzzDecodeRuntimeEvent": "\x11\x00\x02\x00\x000",
+		} {
+			files["types/testdata/fuzz/"+target+"/oom"] = []byte(fmt.Sprintf("go test fuzz v1\n[]byte(%q)\n", input))
+		}
+		fuzz := func(target string) []string {
+			return []string{"test", "-run", "^$", "-fuzz", "^" + target + "$", "-fuzztime", "5s", "./types"}
+		}
+		build(t, "example.com/example", files, fuzz("FuzzDecodeRuntimeCall"), fuzz("FuzzDecodeRuntimeEvent"))
+	})
+	t.Run("versions", func(t *testing.T) {
+		files, err := GenerateVersions(versions, Options{PkgPath: "example.com/versions", WithContext: true, WithTests: true, WithChainTest: true})
+		require.NoError(t, err)
+		usage, err := os.ReadFile(filepath.Join("testdata", "usage", "versions_test.go"))
+		require.NoError(t, err)
+		files["usage/usage_test.go"] = usage
+		build(t, "example.com/versions", files)
+	})
+}
+
+// The go code of a fixture's protobuf messages, generated by protoc-gen-go. It's checked in at
+// testdata/protobuf/$FIXTURE along with the messages it's generated from, since protoc usually
+// isn't installed. When protoc and protoc-gen-go are on the PATH the code is generated instead, and
+// -update rewrites the checked in files with it.
+func protobufCode(t *testing.T, fixture string, proto []byte) []byte {
+	dir := filepath.Join("testdata", "protobuf", fixture)
+	_, errProtoc := exec.LookPath("protoc")
+	_, errGen := exec.LookPath("protoc-gen-go")
+	if errProtoc != nil || errGen != nil {
+		checked, err := os.ReadFile(filepath.Join(dir, protogen.ProtoFile))
+		require.NoError(t, err)
+		require.Equal(t, string(checked), string(proto),
+			"%v is out of date, regenerate it by running go test ./gen -update with protoc and protoc-gen-go v1.31.0 on the PATH", dir)
+		code, err := os.ReadFile(filepath.Join(dir, "typespb", "types.pb.go"))
+		require.NoError(t, err)
+		return code
+	}
+
+	tmp := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(tmp, "typespb"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(tmp, protogen.ProtoFile), proto, 0644))
+	cmd := exec.Command("protoc", "--go_out=paths=source_relative:.", protogen.ProtoFile)
+	cmd.Dir = tmp
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "protoc:\n%s", out)
+	code, err := os.ReadFile(filepath.Join(tmp, "typespb", "types.pb.go"))
+	require.NoError(t, err)
+	if *update {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "typespb"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, protogen.ProtoFile), proto, 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "typespb", "types.pb.go"), code, 0644))
+	}
+	return code
+}
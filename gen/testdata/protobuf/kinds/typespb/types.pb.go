@@ -0,0 +1,2856 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: typespb/types.proto
+
+package typespb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{0}
+}
+
+type AccountData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Free     string `protobuf:"bytes,1,opt,name=free,proto3" json:"free,omitempty"`
+	Reserved string `protobuf:"bytes,2,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Flags    uint32 `protobuf:"varint,3,opt,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *AccountData) Reset() {
+	*x = AccountData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountData) ProtoMessage() {}
+
+func (x *AccountData) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountData.ProtoReflect.Descriptor instead.
+func (*AccountData) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountData) GetFree() string {
+	if x != nil {
+		return x.Free
+	}
+	return ""
+}
+
+func (x *AccountData) GetReserved() string {
+	if x != nil {
+		return x.Reserved
+	}
+	return ""
+}
+
+func (x *AccountData) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type CheckGenesis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckGenesis) Reset() {
+	*x = CheckGenesis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckGenesis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGenesis) ProtoMessage() {}
+
+func (x *CheckGenesis) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGenesis.ProtoReflect.Descriptor instead.
+func (*CheckGenesis) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{2}
+}
+
+type CheckSpecVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckSpecVersion) Reset() {
+	*x = CheckSpecVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSpecVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSpecVersion) ProtoMessage() {}
+
+func (x *CheckSpecVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSpecVersion.ProtoReflect.Descriptor instead.
+func (*CheckSpecVersion) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{3}
+}
+
+type DispatchClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*DispatchClass_Normal
+	//	*DispatchClass_Operational
+	//	*DispatchClass_Mandatory
+	Variant isDispatchClass_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *DispatchClass) Reset() {
+	*x = DispatchClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchClass) ProtoMessage() {}
+
+func (x *DispatchClass) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchClass.ProtoReflect.Descriptor instead.
+func (*DispatchClass) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *DispatchClass) GetVariant() isDispatchClass_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *DispatchClass) GetNormal() *Empty {
+	if x, ok := x.GetVariant().(*DispatchClass_Normal); ok {
+		return x.Normal
+	}
+	return nil
+}
+
+func (x *DispatchClass) GetOperational() *Empty {
+	if x, ok := x.GetVariant().(*DispatchClass_Operational); ok {
+		return x.Operational
+	}
+	return nil
+}
+
+func (x *DispatchClass) GetMandatory() *Empty {
+	if x, ok := x.GetVariant().(*DispatchClass_Mandatory); ok {
+		return x.Mandatory
+	}
+	return nil
+}
+
+type isDispatchClass_Variant interface {
+	isDispatchClass_Variant()
+}
+
+type DispatchClass_Normal struct {
+	Normal *Empty `protobuf:"bytes,1,opt,name=normal,proto3,oneof"`
+}
+
+type DispatchClass_Operational struct {
+	Operational *Empty `protobuf:"bytes,2,opt,name=operational,proto3,oneof"`
+}
+
+type DispatchClass_Mandatory struct {
+	Mandatory *Empty `protobuf:"bytes,3,opt,name=mandatory,proto3,oneof"`
+}
+
+func (*DispatchClass_Normal) isDispatchClass_Variant() {}
+
+func (*DispatchClass_Operational) isDispatchClass_Variant() {}
+
+func (*DispatchClass_Mandatory) isDispatchClass_Variant() {}
+
+type DispatchInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weight  uint64         `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Class   *DispatchClass `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	PaysFee *Pays          `protobuf:"bytes,3,opt,name=pays_fee,json=paysFee,proto3" json:"pays_fee,omitempty"`
+}
+
+func (x *DispatchInfo) Reset() {
+	*x = DispatchInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchInfo) ProtoMessage() {}
+
+func (x *DispatchInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchInfo.ProtoReflect.Descriptor instead.
+func (*DispatchInfo) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DispatchInfo) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *DispatchInfo) GetClass() *DispatchClass {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+func (x *DispatchInfo) GetPaysFee() *Pays {
+	if x != nil {
+		return x.PaysFee
+	}
+	return nil
+}
+
+type EventRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event  *RuntimeEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Topics [][]byte      `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *EventRecord) Reset() {
+	*x = EventRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRecord) ProtoMessage() {}
+
+func (x *EventRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRecord.ProtoReflect.Descriptor instead.
+func (*EventRecord) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventRecord) GetEvent() *RuntimeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventRecord) GetTopics() [][]byte {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type FrameSystemPalletCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*FrameSystemPalletCall_Remark
+	Variant isFrameSystemPalletCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *FrameSystemPalletCall) Reset() {
+	*x = FrameSystemPalletCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSystemPalletCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSystemPalletCall) ProtoMessage() {}
+
+func (x *FrameSystemPalletCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSystemPalletCall.ProtoReflect.Descriptor instead.
+func (*FrameSystemPalletCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *FrameSystemPalletCall) GetVariant() isFrameSystemPalletCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletCall) GetRemark() []byte {
+	if x, ok := x.GetVariant().(*FrameSystemPalletCall_Remark); ok {
+		return x.Remark
+	}
+	return nil
+}
+
+type isFrameSystemPalletCall_Variant interface {
+	isFrameSystemPalletCall_Variant()
+}
+
+type FrameSystemPalletCall_Remark struct {
+	Remark []byte `protobuf:"bytes,1,opt,name=remark,proto3,oneof"`
+}
+
+func (*FrameSystemPalletCall_Remark) isFrameSystemPalletCall_Variant() {}
+
+type FrameSystemPalletEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*FrameSystemPalletEvent_ExtrinsicSuccess
+	//	*FrameSystemPalletEvent_Remarked
+	Variant isFrameSystemPalletEvent_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *FrameSystemPalletEvent) Reset() {
+	*x = FrameSystemPalletEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSystemPalletEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSystemPalletEvent) ProtoMessage() {}
+
+func (x *FrameSystemPalletEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSystemPalletEvent.ProtoReflect.Descriptor instead.
+func (*FrameSystemPalletEvent) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *FrameSystemPalletEvent) GetVariant() isFrameSystemPalletEvent_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletEvent) GetExtrinsicSuccess() *DispatchInfo {
+	if x, ok := x.GetVariant().(*FrameSystemPalletEvent_ExtrinsicSuccess); ok {
+		return x.ExtrinsicSuccess
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletEvent) GetRemarked() *FrameSystemPalletEvent_RemarkedFields {
+	if x, ok := x.GetVariant().(*FrameSystemPalletEvent_Remarked); ok {
+		return x.Remarked
+	}
+	return nil
+}
+
+type isFrameSystemPalletEvent_Variant interface {
+	isFrameSystemPalletEvent_Variant()
+}
+
+type FrameSystemPalletEvent_ExtrinsicSuccess struct {
+	ExtrinsicSuccess *DispatchInfo `protobuf:"bytes,1,opt,name=extrinsic_success,json=extrinsicSuccess,proto3,oneof"`
+}
+
+type FrameSystemPalletEvent_Remarked struct {
+	Remarked *FrameSystemPalletEvent_RemarkedFields `protobuf:"bytes,2,opt,name=remarked,proto3,oneof"`
+}
+
+func (*FrameSystemPalletEvent_ExtrinsicSuccess) isFrameSystemPalletEvent_Variant() {}
+
+func (*FrameSystemPalletEvent_Remarked) isFrameSystemPalletEvent_Variant() {}
+
+type MultiAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*MultiAddress_Id
+	//	*MultiAddress_Index
+	//	*MultiAddress_Raw
+	Variant isMultiAddress_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *MultiAddress) Reset() {
+	*x = MultiAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiAddress) ProtoMessage() {}
+
+func (x *MultiAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiAddress.ProtoReflect.Descriptor instead.
+func (*MultiAddress) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *MultiAddress) GetVariant() isMultiAddress_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *MultiAddress) GetId() []byte {
+	if x, ok := x.GetVariant().(*MultiAddress_Id); ok {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *MultiAddress) GetIndex() *Empty {
+	if x, ok := x.GetVariant().(*MultiAddress_Index); ok {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *MultiAddress) GetRaw() []byte {
+	if x, ok := x.GetVariant().(*MultiAddress_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+type isMultiAddress_Variant interface {
+	isMultiAddress_Variant()
+}
+
+type MultiAddress_Id struct {
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type MultiAddress_Index struct {
+	Index *Empty `protobuf:"bytes,2,opt,name=index,proto3,oneof"`
+}
+
+type MultiAddress_Raw struct {
+	Raw []byte `protobuf:"bytes,3,opt,name=raw,proto3,oneof"`
+}
+
+func (*MultiAddress_Id) isMultiAddress_Variant() {}
+
+func (*MultiAddress_Index) isMultiAddress_Variant() {}
+
+func (*MultiAddress_Raw) isMultiAddress_Variant() {}
+
+type MultiSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*MultiSignature_Ed25519
+	//	*MultiSignature_Sr25519
+	Variant isMultiSignature_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *MultiSignature) Reset() {
+	*x = MultiSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSignature) ProtoMessage() {}
+
+func (x *MultiSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSignature.ProtoReflect.Descriptor instead.
+func (*MultiSignature) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *MultiSignature) GetVariant() isMultiSignature_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *MultiSignature) GetEd25519() []byte {
+	if x, ok := x.GetVariant().(*MultiSignature_Ed25519); ok {
+		return x.Ed25519
+	}
+	return nil
+}
+
+func (x *MultiSignature) GetSr25519() []byte {
+	if x, ok := x.GetVariant().(*MultiSignature_Sr25519); ok {
+		return x.Sr25519
+	}
+	return nil
+}
+
+type isMultiSignature_Variant interface {
+	isMultiSignature_Variant()
+}
+
+type MultiSignature_Ed25519 struct {
+	Ed25519 []byte `protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
+}
+
+type MultiSignature_Sr25519 struct {
+	Sr25519 []byte `protobuf:"bytes,2,opt,name=sr25519,proto3,oneof"`
+}
+
+func (*MultiSignature_Ed25519) isMultiSignature_Variant() {}
+
+func (*MultiSignature_Sr25519) isMultiSignature_Variant() {}
+
+type OptionTUint32 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*OptionTUint32_None
+	//	*OptionTUint32_Some
+	Variant isOptionTUint32_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *OptionTUint32) Reset() {
+	*x = OptionTUint32{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptionTUint32) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionTUint32) ProtoMessage() {}
+
+func (x *OptionTUint32) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionTUint32.ProtoReflect.Descriptor instead.
+func (*OptionTUint32) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *OptionTUint32) GetVariant() isOptionTUint32_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *OptionTUint32) GetNone() *Empty {
+	if x, ok := x.GetVariant().(*OptionTUint32_None); ok {
+		return x.None
+	}
+	return nil
+}
+
+func (x *OptionTUint32) GetSome() uint32 {
+	if x, ok := x.GetVariant().(*OptionTUint32_Some); ok {
+		return x.Some
+	}
+	return 0
+}
+
+type isOptionTUint32_Variant interface {
+	isOptionTUint32_Variant()
+}
+
+type OptionTUint32_None struct {
+	None *Empty `protobuf:"bytes,1,opt,name=none,proto3,oneof"`
+}
+
+type OptionTUint32_Some struct {
+	Some uint32 `protobuf:"varint,2,opt,name=some,proto3,oneof"`
+}
+
+func (*OptionTUint32_None) isOptionTUint32_Variant() {}
+
+func (*OptionTUint32_Some) isOptionTUint32_Variant() {}
+
+type PalletKindsPalletCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*PalletKindsPalletCall_AllKinds
+	//	*PalletKindsPalletCall_Dispatch
+	//	*PalletKindsPalletCall_Unused
+	Variant isPalletKindsPalletCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *PalletKindsPalletCall) Reset() {
+	*x = PalletKindsPalletCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletKindsPalletCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletKindsPalletCall) ProtoMessage() {}
+
+func (x *PalletKindsPalletCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletKindsPalletCall.ProtoReflect.Descriptor instead.
+func (*PalletKindsPalletCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *PalletKindsPalletCall) GetVariant() isPalletKindsPalletCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall) GetAllKinds() *PalletKindsPalletCall_AllKindsFields {
+	if x, ok := x.GetVariant().(*PalletKindsPalletCall_AllKinds); ok {
+		return x.AllKinds
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall) GetDispatch() *RuntimeCall {
+	if x, ok := x.GetVariant().(*PalletKindsPalletCall_Dispatch); ok {
+		return x.Dispatch
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall) GetUnused() *Empty {
+	if x, ok := x.GetVariant().(*PalletKindsPalletCall_Unused); ok {
+		return x.Unused
+	}
+	return nil
+}
+
+type isPalletKindsPalletCall_Variant interface {
+	isPalletKindsPalletCall_Variant()
+}
+
+type PalletKindsPalletCall_AllKinds struct {
+	AllKinds *PalletKindsPalletCall_AllKindsFields `protobuf:"bytes,1,opt,name=all_kinds,json=allKinds,proto3,oneof"`
+}
+
+type PalletKindsPalletCall_Dispatch struct {
+	Dispatch *RuntimeCall `protobuf:"bytes,2,opt,name=dispatch,proto3,oneof"`
+}
+
+type PalletKindsPalletCall_Unused struct {
+	Unused *Empty `protobuf:"bytes,3,opt,name=unused,proto3,oneof"`
+}
+
+func (*PalletKindsPalletCall_AllKinds) isPalletKindsPalletCall_Variant() {}
+
+func (*PalletKindsPalletCall_Dispatch) isPalletKindsPalletCall_Variant() {}
+
+func (*PalletKindsPalletCall_Unused) isPalletKindsPalletCall_Variant() {}
+
+type PalletKindsPalletEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*PalletKindsPalletEvent_Happened
+	//	*PalletKindsPalletEvent_StatusChanged
+	Variant isPalletKindsPalletEvent_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *PalletKindsPalletEvent) Reset() {
+	*x = PalletKindsPalletEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletKindsPalletEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletKindsPalletEvent) ProtoMessage() {}
+
+func (x *PalletKindsPalletEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletKindsPalletEvent.ProtoReflect.Descriptor instead.
+func (*PalletKindsPalletEvent) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *PalletKindsPalletEvent) GetVariant() isPalletKindsPalletEvent_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletEvent) GetHappened() *PalletKindsPalletEvent_HappenedFields {
+	if x, ok := x.GetVariant().(*PalletKindsPalletEvent_Happened); ok {
+		return x.Happened
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletEvent) GetStatusChanged() *Status {
+	if x, ok := x.GetVariant().(*PalletKindsPalletEvent_StatusChanged); ok {
+		return x.StatusChanged
+	}
+	return nil
+}
+
+type isPalletKindsPalletEvent_Variant interface {
+	isPalletKindsPalletEvent_Variant()
+}
+
+type PalletKindsPalletEvent_Happened struct {
+	Happened *PalletKindsPalletEvent_HappenedFields `protobuf:"bytes,1,opt,name=happened,proto3,oneof"`
+}
+
+type PalletKindsPalletEvent_StatusChanged struct {
+	StatusChanged *Status `protobuf:"bytes,2,opt,name=status_changed,json=statusChanged,proto3,oneof"`
+}
+
+func (*PalletKindsPalletEvent_Happened) isPalletKindsPalletEvent_Variant() {}
+
+func (*PalletKindsPalletEvent_StatusChanged) isPalletKindsPalletEvent_Variant() {}
+
+type Pays struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*Pays_Yes
+	//	*Pays_No
+	Variant isPays_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *Pays) Reset() {
+	*x = Pays{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pays) ProtoMessage() {}
+
+func (x *Pays) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pays.ProtoReflect.Descriptor instead.
+func (*Pays) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (m *Pays) GetVariant() isPays_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *Pays) GetYes() *Empty {
+	if x, ok := x.GetVariant().(*Pays_Yes); ok {
+		return x.Yes
+	}
+	return nil
+}
+
+func (x *Pays) GetNo() *Empty {
+	if x, ok := x.GetVariant().(*Pays_No); ok {
+		return x.No
+	}
+	return nil
+}
+
+type isPays_Variant interface {
+	isPays_Variant()
+}
+
+type Pays_Yes struct {
+	Yes *Empty `protobuf:"bytes,1,opt,name=yes,proto3,oneof"`
+}
+
+type Pays_No struct {
+	No *Empty `protobuf:"bytes,2,opt,name=no,proto3,oneof"`
+}
+
+func (*Pays_Yes) isPays_Variant() {}
+
+func (*Pays_No) isPays_Variant() {}
+
+type Primitives struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Abool bool   `protobuf:"varint,1,opt,name=abool,proto3" json:"abool,omitempty"`
+	Achar int32  `protobuf:"zigzag32,2,opt,name=achar,proto3" json:"achar,omitempty"`
+	Astr  string `protobuf:"bytes,3,opt,name=astr,proto3" json:"astr,omitempty"`
+	Au8   uint32 `protobuf:"varint,4,opt,name=au8,proto3" json:"au8,omitempty"`
+	Au16  uint32 `protobuf:"varint,5,opt,name=au16,proto3" json:"au16,omitempty"`
+	Au32  uint32 `protobuf:"varint,6,opt,name=au32,proto3" json:"au32,omitempty"`
+	Au64  uint64 `protobuf:"varint,7,opt,name=au64,proto3" json:"au64,omitempty"`
+	Au128 string `protobuf:"bytes,8,opt,name=au128,proto3" json:"au128,omitempty"`
+	Au256 string `protobuf:"bytes,9,opt,name=au256,proto3" json:"au256,omitempty"`
+	Ai8   int32  `protobuf:"zigzag32,10,opt,name=ai8,proto3" json:"ai8,omitempty"`
+	Ai16  int32  `protobuf:"zigzag32,11,opt,name=ai16,proto3" json:"ai16,omitempty"`
+	Ai32  int32  `protobuf:"zigzag32,12,opt,name=ai32,proto3" json:"ai32,omitempty"`
+	Ai64  int64  `protobuf:"zigzag64,13,opt,name=ai64,proto3" json:"ai64,omitempty"`
+	Ai128 string `protobuf:"bytes,14,opt,name=ai128,proto3" json:"ai128,omitempty"`
+	Ai256 string `protobuf:"bytes,15,opt,name=ai256,proto3" json:"ai256,omitempty"`
+}
+
+func (x *Primitives) Reset() {
+	*x = Primitives{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Primitives) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Primitives) ProtoMessage() {}
+
+func (x *Primitives) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Primitives.ProtoReflect.Descriptor instead.
+func (*Primitives) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Primitives) GetAbool() bool {
+	if x != nil {
+		return x.Abool
+	}
+	return false
+}
+
+func (x *Primitives) GetAchar() int32 {
+	if x != nil {
+		return x.Achar
+	}
+	return 0
+}
+
+func (x *Primitives) GetAstr() string {
+	if x != nil {
+		return x.Astr
+	}
+	return ""
+}
+
+func (x *Primitives) GetAu8() uint32 {
+	if x != nil {
+		return x.Au8
+	}
+	return 0
+}
+
+func (x *Primitives) GetAu16() uint32 {
+	if x != nil {
+		return x.Au16
+	}
+	return 0
+}
+
+func (x *Primitives) GetAu32() uint32 {
+	if x != nil {
+		return x.Au32
+	}
+	return 0
+}
+
+func (x *Primitives) GetAu64() uint64 {
+	if x != nil {
+		return x.Au64
+	}
+	return 0
+}
+
+func (x *Primitives) GetAu128() string {
+	if x != nil {
+		return x.Au128
+	}
+	return ""
+}
+
+func (x *Primitives) GetAu256() string {
+	if x != nil {
+		return x.Au256
+	}
+	return ""
+}
+
+func (x *Primitives) GetAi8() int32 {
+	if x != nil {
+		return x.Ai8
+	}
+	return 0
+}
+
+func (x *Primitives) GetAi16() int32 {
+	if x != nil {
+		return x.Ai16
+	}
+	return 0
+}
+
+func (x *Primitives) GetAi32() int32 {
+	if x != nil {
+		return x.Ai32
+	}
+	return 0
+}
+
+func (x *Primitives) GetAi64() int64 {
+	if x != nil {
+		return x.Ai64
+	}
+	return 0
+}
+
+func (x *Primitives) GetAi128() string {
+	if x != nil {
+		return x.Ai128
+	}
+	return ""
+}
+
+func (x *Primitives) GetAi256() string {
+	if x != nil {
+		return x.Ai256
+	}
+	return ""
+}
+
+type RuntimeCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*RuntimeCall_System
+	//	*RuntimeCall_Kinds
+	Variant isRuntimeCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *RuntimeCall) Reset() {
+	*x = RuntimeCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeCall) ProtoMessage() {}
+
+func (x *RuntimeCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeCall.ProtoReflect.Descriptor instead.
+func (*RuntimeCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (m *RuntimeCall) GetVariant() isRuntimeCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *RuntimeCall) GetSystem() *FrameSystemPalletCall {
+	if x, ok := x.GetVariant().(*RuntimeCall_System); ok {
+		return x.System
+	}
+	return nil
+}
+
+func (x *RuntimeCall) GetKinds() *PalletKindsPalletCall {
+	if x, ok := x.GetVariant().(*RuntimeCall_Kinds); ok {
+		return x.Kinds
+	}
+	return nil
+}
+
+type isRuntimeCall_Variant interface {
+	isRuntimeCall_Variant()
+}
+
+type RuntimeCall_System struct {
+	System *FrameSystemPalletCall `protobuf:"bytes,1,opt,name=system,proto3,oneof"`
+}
+
+type RuntimeCall_Kinds struct {
+	Kinds *PalletKindsPalletCall `protobuf:"bytes,2,opt,name=kinds,proto3,oneof"`
+}
+
+func (*RuntimeCall_System) isRuntimeCall_Variant() {}
+
+func (*RuntimeCall_Kinds) isRuntimeCall_Variant() {}
+
+type RuntimeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*RuntimeEvent_System
+	//	*RuntimeEvent_Kinds
+	Variant isRuntimeEvent_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *RuntimeEvent) Reset() {
+	*x = RuntimeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeEvent) ProtoMessage() {}
+
+func (x *RuntimeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeEvent.ProtoReflect.Descriptor instead.
+func (*RuntimeEvent) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *RuntimeEvent) GetVariant() isRuntimeEvent_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *RuntimeEvent) GetSystem() *FrameSystemPalletEvent {
+	if x, ok := x.GetVariant().(*RuntimeEvent_System); ok {
+		return x.System
+	}
+	return nil
+}
+
+func (x *RuntimeEvent) GetKinds() *PalletKindsPalletEvent {
+	if x, ok := x.GetVariant().(*RuntimeEvent_Kinds); ok {
+		return x.Kinds
+	}
+	return nil
+}
+
+type isRuntimeEvent_Variant interface {
+	isRuntimeEvent_Variant()
+}
+
+type RuntimeEvent_System struct {
+	System *FrameSystemPalletEvent `protobuf:"bytes,1,opt,name=system,proto3,oneof"`
+}
+
+type RuntimeEvent_Kinds struct {
+	Kinds *PalletKindsPalletEvent `protobuf:"bytes,2,opt,name=kinds,proto3,oneof"`
+}
+
+func (*RuntimeEvent_System) isRuntimeEvent_Variant() {}
+
+func (*RuntimeEvent_Kinds) isRuntimeEvent_Variant() {}
+
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*Status_Active
+	//	*Status_Inactive
+	//	*Status_Frozen
+	//	*Status_Slashed
+	Variant isStatus_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{18}
+}
+
+func (m *Status) GetVariant() isStatus_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *Status) GetActive() *Empty {
+	if x, ok := x.GetVariant().(*Status_Active); ok {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *Status) GetInactive() *Empty {
+	if x, ok := x.GetVariant().(*Status_Inactive); ok {
+		return x.Inactive
+	}
+	return nil
+}
+
+func (x *Status) GetFrozen() *Status_FrozenFields {
+	if x, ok := x.GetVariant().(*Status_Frozen); ok {
+		return x.Frozen
+	}
+	return nil
+}
+
+func (x *Status) GetSlashed() uint32 {
+	if x, ok := x.GetVariant().(*Status_Slashed); ok {
+		return x.Slashed
+	}
+	return 0
+}
+
+type isStatus_Variant interface {
+	isStatus_Variant()
+}
+
+type Status_Active struct {
+	Active *Empty `protobuf:"bytes,1,opt,name=active,proto3,oneof"`
+}
+
+type Status_Inactive struct {
+	Inactive *Empty `protobuf:"bytes,2,opt,name=inactive,proto3,oneof"`
+}
+
+type Status_Frozen struct {
+	Frozen *Status_FrozenFields `protobuf:"bytes,3,opt,name=frozen,proto3,oneof"`
+}
+
+type Status_Slashed struct {
+	Slashed uint32 `protobuf:"varint,4,opt,name=slashed,proto3,oneof"`
+}
+
+func (*Status_Active) isStatus_Variant() {}
+
+func (*Status_Inactive) isStatus_Variant() {}
+
+func (*Status_Frozen) isStatus_Variant() {}
+
+func (*Status_Slashed) isStatus_Variant() {}
+
+type Tree struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*Tree_Leaf
+	//	*Tree_Node
+	Variant isTree_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *Tree) Reset() {
+	*x = Tree{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tree) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tree) ProtoMessage() {}
+
+func (x *Tree) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tree.ProtoReflect.Descriptor instead.
+func (*Tree) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{19}
+}
+
+func (m *Tree) GetVariant() isTree_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *Tree) GetLeaf() uint32 {
+	if x, ok := x.GetVariant().(*Tree_Leaf); ok {
+		return x.Leaf
+	}
+	return 0
+}
+
+func (x *Tree) GetNode() *TreeSlice {
+	if x, ok := x.GetVariant().(*Tree_Node); ok {
+		return x.Node
+	}
+	return nil
+}
+
+type isTree_Variant interface {
+	isTree_Variant()
+}
+
+type Tree_Leaf struct {
+	Leaf uint32 `protobuf:"varint,1,opt,name=leaf,proto3,oneof"`
+}
+
+type Tree_Node struct {
+	Node *TreeSlice `protobuf:"bytes,2,opt,name=node,proto3,oneof"`
+}
+
+func (*Tree_Leaf) isTree_Variant() {}
+
+func (*Tree_Node) isTree_Variant() {}
+
+type Tuple47 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elem0 uint32 `protobuf:"varint,1,opt,name=elem0,proto3" json:"elem0,omitempty"`
+	Elem1 uint32 `protobuf:"varint,2,opt,name=elem1,proto3" json:"elem1,omitempty"`
+	Elem2 uint32 `protobuf:"varint,3,opt,name=elem2,proto3" json:"elem2,omitempty"`
+}
+
+func (x *Tuple47) Reset() {
+	*x = Tuple47{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tuple47) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tuple47) ProtoMessage() {}
+
+func (x *Tuple47) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tuple47.ProtoReflect.Descriptor instead.
+func (*Tuple47) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Tuple47) GetElem0() uint32 {
+	if x != nil {
+		return x.Elem0
+	}
+	return 0
+}
+
+func (x *Tuple47) GetElem1() uint32 {
+	if x != nil {
+		return x.Elem1
+	}
+	return 0
+}
+
+func (x *Tuple47) GetElem2() uint32 {
+	if x != nil {
+		return x.Elem2
+	}
+	return 0
+}
+
+type TupleOfByteArray32Uint32 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elem0 []byte `protobuf:"bytes,1,opt,name=elem0,proto3" json:"elem0,omitempty"`
+	Elem1 uint32 `protobuf:"varint,2,opt,name=elem1,proto3" json:"elem1,omitempty"`
+}
+
+func (x *TupleOfByteArray32Uint32) Reset() {
+	*x = TupleOfByteArray32Uint32{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TupleOfByteArray32Uint32) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TupleOfByteArray32Uint32) ProtoMessage() {}
+
+func (x *TupleOfByteArray32Uint32) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TupleOfByteArray32Uint32.ProtoReflect.Descriptor instead.
+func (*TupleOfByteArray32Uint32) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TupleOfByteArray32Uint32) GetElem0() []byte {
+	if x != nil {
+		return x.Elem0
+	}
+	return nil
+}
+
+func (x *TupleOfByteArray32Uint32) GetElem1() uint32 {
+	if x != nil {
+		return x.Elem1
+	}
+	return 0
+}
+
+type TupleOfUint32Uint64 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Elem0 uint32 `protobuf:"varint,1,opt,name=elem0,proto3" json:"elem0,omitempty"`
+	Elem1 uint64 `protobuf:"varint,2,opt,name=elem1,proto3" json:"elem1,omitempty"`
+}
+
+func (x *TupleOfUint32Uint64) Reset() {
+	*x = TupleOfUint32Uint64{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TupleOfUint32Uint64) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TupleOfUint32Uint64) ProtoMessage() {}
+
+func (x *TupleOfUint32Uint64) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TupleOfUint32Uint64.ProtoReflect.Descriptor instead.
+func (*TupleOfUint32Uint64) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TupleOfUint32Uint64) GetElem0() uint32 {
+	if x != nil {
+		return x.Elem0
+	}
+	return 0
+}
+
+func (x *TupleOfUint32Uint64) GetElem1() uint64 {
+	if x != nil {
+		return x.Elem1
+	}
+	return 0
+}
+
+type TreeSlice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Tree `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *TreeSlice) Reset() {
+	*x = TreeSlice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TreeSlice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeSlice) ProtoMessage() {}
+
+func (x *TreeSlice) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeSlice.ProtoReflect.Descriptor instead.
+func (*TreeSlice) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TreeSlice) GetItems() []*Tree {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type FrameSystemPalletEvent_RemarkedFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender []byte `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Hash   []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) Reset() {
+	*x = FrameSystemPalletEvent_RemarkedFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSystemPalletEvent_RemarkedFields) ProtoMessage() {}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSystemPalletEvent_RemarkedFields.ProtoReflect.Descriptor instead.
+func (*FrameSystemPalletEvent_RemarkedFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type PalletKindsPalletCall_AllKindsFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Primitives *Primitives          `protobuf:"bytes,1,opt,name=primitives,proto3" json:"primitives,omitempty"`
+	Status     *Status              `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Maybe      *OptionTUint32       `protobuf:"bytes,3,opt,name=maybe,proto3" json:"maybe,omitempty"`
+	Accounts   []*AccountData       `protobuf:"bytes,4,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Fixed      []uint32             `protobuf:"varint,5,rep,packed,name=fixed,proto3" json:"fixed,omitempty"`
+	Pair       *TupleOfUint32Uint64 `protobuf:"bytes,6,opt,name=pair,proto3" json:"pair,omitempty"`
+	Triple     *Tuple47             `protobuf:"bytes,7,opt,name=triple,proto3" json:"triple,omitempty"`
+	Single     uint32               `protobuf:"varint,8,opt,name=single,proto3" json:"single,omitempty"`
+	Nothing    *Empty               `protobuf:"bytes,9,opt,name=nothing,proto3" json:"nothing,omitempty"`
+	Small      string               `protobuf:"bytes,10,opt,name=small,proto3" json:"small,omitempty"`
+	Big        string               `protobuf:"bytes,11,opt,name=big,proto3" json:"big,omitempty"`
+	Bits       []byte               `protobuf:"bytes,12,opt,name=bits,proto3" json:"bits,omitempty"`
+	Tree       *Tree                `protobuf:"bytes,13,opt,name=tree,proto3" json:"tree,omitempty"`
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) Reset() {
+	*x = PalletKindsPalletCall_AllKindsFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletKindsPalletCall_AllKindsFields) ProtoMessage() {}
+
+func (x *PalletKindsPalletCall_AllKindsFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletKindsPalletCall_AllKindsFields.ProtoReflect.Descriptor instead.
+func (*PalletKindsPalletCall_AllKindsFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetPrimitives() *Primitives {
+	if x != nil {
+		return x.Primitives
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetMaybe() *OptionTUint32 {
+	if x != nil {
+		return x.Maybe
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetAccounts() []*AccountData {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetFixed() []uint32 {
+	if x != nil {
+		return x.Fixed
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetPair() *TupleOfUint32Uint64 {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetTriple() *Tuple47 {
+	if x != nil {
+		return x.Triple
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetSingle() uint32 {
+	if x != nil {
+		return x.Single
+	}
+	return 0
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetNothing() *Empty {
+	if x != nil {
+		return x.Nothing
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetSmall() string {
+	if x != nil {
+		return x.Small
+	}
+	return ""
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetBig() string {
+	if x != nil {
+		return x.Big
+	}
+	return ""
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetBits() []byte {
+	if x != nil {
+		return x.Bits
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletCall_AllKindsFields) GetTree() *Tree {
+	if x != nil {
+		return x.Tree
+	}
+	return nil
+}
+
+type PalletKindsPalletEvent_HappenedFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Who    []byte `protobuf:"bytes,1,opt,name=who,proto3" json:"who,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PalletKindsPalletEvent_HappenedFields) Reset() {
+	*x = PalletKindsPalletEvent_HappenedFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletKindsPalletEvent_HappenedFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletKindsPalletEvent_HappenedFields) ProtoMessage() {}
+
+func (x *PalletKindsPalletEvent_HappenedFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletKindsPalletEvent_HappenedFields.ProtoReflect.Descriptor instead.
+func (*PalletKindsPalletEvent_HappenedFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *PalletKindsPalletEvent_HappenedFields) GetWho() []byte {
+	if x != nil {
+		return x.Who
+	}
+	return nil
+}
+
+func (x *PalletKindsPalletEvent_HappenedFields) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type Status_FrozenFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Until  uint32 `protobuf:"varint,1,opt,name=until,proto3" json:"until,omitempty"`
+	Reason []byte `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *Status_FrozenFields) Reset() {
+	*x = Status_FrozenFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status_FrozenFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status_FrozenFields) ProtoMessage() {}
+
+func (x *Status_FrozenFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status_FrozenFields.ProtoReflect.Descriptor instead.
+func (*Status_FrozenFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *Status_FrozenFields) GetUntil() uint32 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *Status_FrozenFields) GetReason() []byte {
+	if x != nil {
+		return x.Reason
+	}
+	return nil
+}
+
+var File_typespb_types_proto protoreflect.FileDescriptor
+
+var file_typespb_types_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x79, 0x70, 0x65, 0x73, 0x70, 0x62, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x07,
+	0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x53, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x0e, 0x0a, 0x0c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x22, 0x12, 0x0a, 0x10,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0xd8, 0x01, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x0b,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x12, 0x3e, 0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79,
+	0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x0c,
+	0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x69,
+	0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x05, 0x63, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50,
+	0x61, 0x79, 0x73, 0x52, 0x07, 0x70, 0x61, 0x79, 0x73, 0x46, 0x65, 0x65, 0x22, 0x62, 0x0a, 0x0b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3b, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x22, 0x3c, 0x0a, 0x15, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x72, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x95,
+	0x02, 0x0a, 0x16, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x11, 0x65, 0x78, 0x74,
+	0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44,
+	0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x10, 0x65,
+	0x78, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x5c, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x3e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x1a, 0x3c, 0x0a,
+	0x0e, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x42, 0x09, 0x0a, 0x07, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x12, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x03, 0x72, 0x61, 0x77, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22,
+	0x53, 0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x1a, 0x0a, 0x07, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x12, 0x1a, 0x0a,
+	0x07, 0x73, 0x72, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x07, 0x73, 0x72, 0x32, 0x35, 0x35, 0x31, 0x39, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x22, 0x66, 0x0a, 0x0d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x55,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x73,
+	0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6f, 0x6d,
+	0x65, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0xe2, 0x06, 0x0a,
+	0x15, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x50, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x5c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x5f, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x50,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x41, 0x6c, 0x6c, 0x4b, 0x69, 0x6e,
+	0x64, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x00, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x4b,
+	0x69, 0x6e, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x12, 0x38, 0x0a, 0x06, 0x75, 0x6e, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x06, 0x75, 0x6e, 0x75, 0x73,
+	0x65, 0x64, 0x1a, 0xe1, 0x04, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x05, 0x6d, 0x61, 0x79, 0x62,
+	0x65, 0x12, 0x40, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x04, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x4f, 0x66, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x55,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x38, 0x0a, 0x06, 0x74,
+	0x72, 0x69, 0x70, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x34, 0x37, 0x52, 0x06, 0x74,
+	0x72, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x38, 0x0a,
+	0x07, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e,
+	0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x07,
+	0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6d, 0x61, 0x6c, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x12, 0x10, 0x0a,
+	0x03, 0x62, 0x69, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x69, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62,
+	0x69, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x72, 0x65, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x04, 0x74, 0x72, 0x65, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x22, 0x87, 0x02, 0x0a, 0x16, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x73, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x08,
+	0x68, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e,
+	0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4b,
+	0x69, 0x6e, 0x64, 0x73, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x48, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x00,
+	0x52, 0x08, 0x68, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x48, 0x0a, 0x0e, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x1a, 0x3a, 0x0a, 0x0e, 0x48, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x65, 0x64,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x77, 0x68, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x77, 0x68, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x04, 0x50,
+	0x61, 0x79, 0x73, 0x12, 0x32, 0x0a, 0x03, 0x79, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b,
+	0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x48, 0x00, 0x52, 0x03, 0x79, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x02, 0x6e, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x02, 0x6e, 0x6f, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x22, 0xc0, 0x02, 0x0a, 0x0a, 0x50, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x68,
+	0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x11, 0x52, 0x05, 0x61, 0x63, 0x68, 0x61, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x73, 0x74, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61,
+	0x73, 0x74, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x75, 0x38, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x61, 0x75, 0x38, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x31, 0x36, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x61, 0x75, 0x31, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x75, 0x33,
+	0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x61, 0x75, 0x33, 0x32, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x75, 0x36, 0x34, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x61, 0x75, 0x36,
+	0x34, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x75, 0x31, 0x32, 0x38, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x75, 0x31, 0x32, 0x38, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x75, 0x32, 0x35, 0x36,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x75, 0x32, 0x35, 0x36, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x69, 0x38, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x61, 0x69, 0x38, 0x12,
+	0x12, 0x0a, 0x04, 0x61, 0x69, 0x31, 0x36, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x11, 0x52, 0x04, 0x61,
+	0x69, 0x31, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x69, 0x33, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x11, 0x52, 0x04, 0x61, 0x69, 0x33, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x69, 0x36, 0x34, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x12, 0x52, 0x04, 0x61, 0x69, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x69, 0x31, 0x32, 0x38, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x69, 0x31, 0x32,
+	0x38, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x69, 0x32, 0x35, 0x36, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x69, 0x32, 0x35, 0x36, 0x22, 0xaa, 0x01, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x12, 0x46, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b,
+	0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x4b, 0x69, 0x6e, 0x64, 0x73, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c,
+	0x48, 0x00, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x12, 0x47, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x4b, 0x69, 0x6e, 0x64, 0x73, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x38, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48,
+	0x00, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x69, 0x6e, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x08, 0x69,
+	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x46, 0x0a, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x00, 0x52, 0x06, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12,
+	0x1a, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x3c, 0x0a, 0x0c, 0x46,
+	0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x04, 0x54, 0x72, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x04,
+	0x6c, 0x65, 0x61, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x04, 0x6c, 0x65,
+	0x61, 0x66, 0x12, 0x38, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b,
+	0x69, 0x6e, 0x64, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x53,
+	0x6c, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x42, 0x09, 0x0a, 0x07,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x4b, 0x0a, 0x07, 0x54, 0x75, 0x70, 0x6c, 0x65,
+	0x34, 0x37, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6c, 0x65, 0x6d, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x65, 0x6c, 0x65, 0x6d, 0x30, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6c, 0x65, 0x6d,
+	0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x6c, 0x65, 0x6d, 0x31, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x6c, 0x65, 0x6d, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65,
+	0x6c, 0x65, 0x6d, 0x32, 0x22, 0x46, 0x0a, 0x18, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x4f, 0x66, 0x42,
+	0x79, 0x74, 0x65, 0x41, 0x72, 0x72, 0x61, 0x79, 0x33, 0x32, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6c, 0x65, 0x6d, 0x30, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x65, 0x6c, 0x65, 0x6d, 0x30, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6c, 0x65, 0x6d, 0x31, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x6c, 0x65, 0x6d, 0x31, 0x22, 0x41, 0x0a, 0x13,
+	0x54, 0x75, 0x70, 0x6c, 0x65, 0x4f, 0x66, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x55, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6c, 0x65, 0x6d, 0x30, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x65, 0x6c, 0x65, 0x6d, 0x30, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6c, 0x65,
+	0x6d, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x6c, 0x65, 0x6d, 0x31, 0x22,
+	0x40, 0x0a, 0x09, 0x54, 0x72, 0x65, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x33, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x42, 0x1b, 0x5a, 0x19, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_typespb_types_proto_rawDescOnce sync.Once
+	file_typespb_types_proto_rawDescData = file_typespb_types_proto_rawDesc
+)
+
+func file_typespb_types_proto_rawDescGZIP() []byte {
+	file_typespb_types_proto_rawDescOnce.Do(func() {
+		file_typespb_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_typespb_types_proto_rawDescData)
+	})
+	return file_typespb_types_proto_rawDescData
+}
+
+var file_typespb_types_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_typespb_types_proto_goTypes = []interface{}{
+	(*Empty)(nil),                                 // 0: example_com.kinds.types.Empty
+	(*AccountData)(nil),                           // 1: example_com.kinds.types.AccountData
+	(*CheckGenesis)(nil),                          // 2: example_com.kinds.types.CheckGenesis
+	(*CheckSpecVersion)(nil),                      // 3: example_com.kinds.types.CheckSpecVersion
+	(*DispatchClass)(nil),                         // 4: example_com.kinds.types.DispatchClass
+	(*DispatchInfo)(nil),                          // 5: example_com.kinds.types.DispatchInfo
+	(*EventRecord)(nil),                           // 6: example_com.kinds.types.EventRecord
+	(*FrameSystemPalletCall)(nil),                 // 7: example_com.kinds.types.FrameSystemPalletCall
+	(*FrameSystemPalletEvent)(nil),                // 8: example_com.kinds.types.FrameSystemPalletEvent
+	(*MultiAddress)(nil),                          // 9: example_com.kinds.types.MultiAddress
+	(*MultiSignature)(nil),                        // 10: example_com.kinds.types.MultiSignature
+	(*OptionTUint32)(nil),                         // 11: example_com.kinds.types.OptionTUint32
+	(*PalletKindsPalletCall)(nil),                 // 12: example_com.kinds.types.PalletKindsPalletCall
+	(*PalletKindsPalletEvent)(nil),                // 13: example_com.kinds.types.PalletKindsPalletEvent
+	(*Pays)(nil),                                  // 14: example_com.kinds.types.Pays
+	(*Primitives)(nil),                            // 15: example_com.kinds.types.Primitives
+	(*RuntimeCall)(nil),                           // 16: example_com.kinds.types.RuntimeCall
+	(*RuntimeEvent)(nil),                          // 17: example_com.kinds.types.RuntimeEvent
+	(*Status)(nil),                                // 18: example_com.kinds.types.Status
+	(*Tree)(nil),                                  // 19: example_com.kinds.types.Tree
+	(*Tuple47)(nil),                               // 20: example_com.kinds.types.Tuple47
+	(*TupleOfByteArray32Uint32)(nil),              // 21: example_com.kinds.types.TupleOfByteArray32Uint32
+	(*TupleOfUint32Uint64)(nil),                   // 22: example_com.kinds.types.TupleOfUint32Uint64
+	(*TreeSlice)(nil),                             // 23: example_com.kinds.types.TreeSlice
+	(*FrameSystemPalletEvent_RemarkedFields)(nil), // 24: example_com.kinds.types.FrameSystemPalletEvent.RemarkedFields
+	(*PalletKindsPalletCall_AllKindsFields)(nil),  // 25: example_com.kinds.types.PalletKindsPalletCall.AllKindsFields
+	(*PalletKindsPalletEvent_HappenedFields)(nil), // 26: example_com.kinds.types.PalletKindsPalletEvent.HappenedFields
+	(*Status_FrozenFields)(nil),                   // 27: example_com.kinds.types.Status.FrozenFields
+}
+var file_typespb_types_proto_depIdxs = []int32{
+	0,  // 0: example_com.kinds.types.DispatchClass.normal:type_name -> example_com.kinds.types.Empty
+	0,  // 1: example_com.kinds.types.DispatchClass.operational:type_name -> example_com.kinds.types.Empty
+	0,  // 2: example_com.kinds.types.DispatchClass.mandatory:type_name -> example_com.kinds.types.Empty
+	4,  // 3: example_com.kinds.types.DispatchInfo.class:type_name -> example_com.kinds.types.DispatchClass
+	14, // 4: example_com.kinds.types.DispatchInfo.pays_fee:type_name -> example_com.kinds.types.Pays
+	17, // 5: example_com.kinds.types.EventRecord.event:type_name -> example_com.kinds.types.RuntimeEvent
+	5,  // 6: example_com.kinds.types.FrameSystemPalletEvent.extrinsic_success:type_name -> example_com.kinds.types.DispatchInfo
+	24, // 7: example_com.kinds.types.FrameSystemPalletEvent.remarked:type_name -> example_com.kinds.types.FrameSystemPalletEvent.RemarkedFields
+	0,  // 8: example_com.kinds.types.MultiAddress.index:type_name -> example_com.kinds.types.Empty
+	0,  // 9: example_com.kinds.types.OptionTUint32.none:type_name -> example_com.kinds.types.Empty
+	25, // 10: example_com.kinds.types.PalletKindsPalletCall.all_kinds:type_name -> example_com.kinds.types.PalletKindsPalletCall.AllKindsFields
+	16, // 11: example_com.kinds.types.PalletKindsPalletCall.dispatch:type_name -> example_com.kinds.types.RuntimeCall
+	0,  // 12: example_com.kinds.types.PalletKindsPalletCall.unused:type_name -> example_com.kinds.types.Empty
+	26, // 13: example_com.kinds.types.PalletKindsPalletEvent.happened:type_name -> example_com.kinds.types.PalletKindsPalletEvent.HappenedFields
+	18, // 14: example_com.kinds.types.PalletKindsPalletEvent.status_changed:type_name -> example_com.kinds.types.Status
+	0,  // 15: example_com.kinds.types.Pays.yes:type_name -> example_com.kinds.types.Empty
+	0,  // 16: example_com.kinds.types.Pays.no:type_name -> example_com.kinds.types.Empty
+	7,  // 17: example_com.kinds.types.RuntimeCall.system:type_name -> example_com.kinds.types.FrameSystemPalletCall
+	12, // 18: example_com.kinds.types.RuntimeCall.kinds:type_name -> example_com.kinds.types.PalletKindsPalletCall
+	8,  // 19: example_com.kinds.types.RuntimeEvent.system:type_name -> example_com.kinds.types.FrameSystemPalletEvent
+	13, // 20: example_com.kinds.types.RuntimeEvent.kinds:type_name -> example_com.kinds.types.PalletKindsPalletEvent
+	0,  // 21: example_com.kinds.types.Status.active:type_name -> example_com.kinds.types.Empty
+	0,  // 22: example_com.kinds.types.Status.inactive:type_name -> example_com.kinds.types.Empty
+	27, // 23: example_com.kinds.types.Status.frozen:type_name -> example_com.kinds.types.Status.FrozenFields
+	23, // 24: example_com.kinds.types.Tree.node:type_name -> example_com.kinds.types.TreeSlice
+	19, // 25: example_com.kinds.types.TreeSlice.items:type_name -> example_com.kinds.types.Tree
+	15, // 26: example_com.kinds.types.PalletKindsPalletCall.AllKindsFields.primitives:type_name -> example_com.kinds.types.Primitives
+	18, // 27: example_com.kinds.types.PalletKindsPalletCall.AllKindsFields.status:type_name -> example_com.kinds.types.Status
+	11, // 28: example_com.kinds.types.PalletKindsPalletCall.AllKindsFields.maybe:type_name -> example_com.kinds.types.OptionTUint32
+	1,  // 29: example_com.kinds.types.PalletKindsPalletCall.AllKindsFields.accounts:type_name -> example_com.kinds.types.AccountData
+	22, // 30: example_com.kinds.types.PalletKindsPalletCall.AllKindsFields.pair:type_name -> example_com.kinds.types.TupleOfUint32Uint64
+	20, // 31: example_com.kinds.types.PalletKindsPalletCall.AllKindsFields.triple:type_name -> example_com.kinds.types.Tuple47
+	0,  // 32: example_com.kinds.types.PalletKindsPalletCall.AllKindsFields.nothing:type_name -> example_com.kinds.types.Empty
+	19, // 33: example_com.kinds.types.PalletKindsPalletCall.AllKindsFields.tree:type_name -> example_com.kinds.types.Tree
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_typespb_types_proto_init() }
+func file_typespb_types_proto_init() {
+	if File_typespb_types_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_typespb_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckGenesis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSpecVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSystemPalletCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSystemPalletEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptionTUint32); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletKindsPalletCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletKindsPalletEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Primitives); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tuple47); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TupleOfByteArray32Uint32); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TupleOfUint32Uint64); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TreeSlice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSystemPalletEvent_RemarkedFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletKindsPalletCall_AllKindsFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletKindsPalletEvent_HappenedFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status_FrozenFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_typespb_types_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*DispatchClass_Normal)(nil),
+		(*DispatchClass_Operational)(nil),
+		(*DispatchClass_Mandatory)(nil),
+	}
+	file_typespb_types_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*FrameSystemPalletCall_Remark)(nil),
+	}
+	file_typespb_types_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*FrameSystemPalletEvent_ExtrinsicSuccess)(nil),
+		(*FrameSystemPalletEvent_Remarked)(nil),
+	}
+	file_typespb_types_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*MultiAddress_Id)(nil),
+		(*MultiAddress_Index)(nil),
+		(*MultiAddress_Raw)(nil),
+	}
+	file_typespb_types_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*MultiSignature_Ed25519)(nil),
+		(*MultiSignature_Sr25519)(nil),
+	}
+	file_typespb_types_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*OptionTUint32_None)(nil),
+		(*OptionTUint32_Some)(nil),
+	}
+	file_typespb_types_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*PalletKindsPalletCall_AllKinds)(nil),
+		(*PalletKindsPalletCall_Dispatch)(nil),
+		(*PalletKindsPalletCall_Unused)(nil),
+	}
+	file_typespb_types_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*PalletKindsPalletEvent_Happened)(nil),
+		(*PalletKindsPalletEvent_StatusChanged)(nil),
+	}
+	file_typespb_types_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*Pays_Yes)(nil),
+		(*Pays_No)(nil),
+	}
+	file_typespb_types_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*RuntimeCall_System)(nil),
+		(*RuntimeCall_Kinds)(nil),
+	}
+	file_typespb_types_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*RuntimeEvent_System)(nil),
+		(*RuntimeEvent_Kinds)(nil),
+	}
+	file_typespb_types_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*Status_Active)(nil),
+		(*Status_Inactive)(nil),
+		(*Status_Frozen)(nil),
+		(*Status_Slashed)(nil),
+	}
+	file_typespb_types_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*Tree_Leaf)(nil),
+		(*Tree_Node)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_typespb_types_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_typespb_types_proto_goTypes,
+		DependencyIndexes: file_typespb_types_proto_depIdxs,
+		MessageInfos:      file_typespb_types_proto_msgTypes,
+	}.Build()
+	File_typespb_types_proto = out.File
+	file_typespb_types_proto_rawDesc = nil
+	file_typespb_types_proto_goTypes = nil
+	file_typespb_types_proto_depIdxs = nil
+}
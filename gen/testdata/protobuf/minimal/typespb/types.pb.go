@@ -0,0 +1,1310 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: typespb/types.proto
+
+package typespb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{0}
+}
+
+type CheckGenesis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckGenesis) Reset() {
+	*x = CheckGenesis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckGenesis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGenesis) ProtoMessage() {}
+
+func (x *CheckGenesis) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGenesis.ProtoReflect.Descriptor instead.
+func (*CheckGenesis) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{1}
+}
+
+type CheckSpecVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckSpecVersion) Reset() {
+	*x = CheckSpecVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSpecVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSpecVersion) ProtoMessage() {}
+
+func (x *CheckSpecVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSpecVersion.ProtoReflect.Descriptor instead.
+func (*CheckSpecVersion) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{2}
+}
+
+type DispatchClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*DispatchClass_Normal
+	//	*DispatchClass_Operational
+	//	*DispatchClass_Mandatory
+	Variant isDispatchClass_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *DispatchClass) Reset() {
+	*x = DispatchClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchClass) ProtoMessage() {}
+
+func (x *DispatchClass) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchClass.ProtoReflect.Descriptor instead.
+func (*DispatchClass) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *DispatchClass) GetVariant() isDispatchClass_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *DispatchClass) GetNormal() *Empty {
+	if x, ok := x.GetVariant().(*DispatchClass_Normal); ok {
+		return x.Normal
+	}
+	return nil
+}
+
+func (x *DispatchClass) GetOperational() *Empty {
+	if x, ok := x.GetVariant().(*DispatchClass_Operational); ok {
+		return x.Operational
+	}
+	return nil
+}
+
+func (x *DispatchClass) GetMandatory() *Empty {
+	if x, ok := x.GetVariant().(*DispatchClass_Mandatory); ok {
+		return x.Mandatory
+	}
+	return nil
+}
+
+type isDispatchClass_Variant interface {
+	isDispatchClass_Variant()
+}
+
+type DispatchClass_Normal struct {
+	Normal *Empty `protobuf:"bytes,1,opt,name=normal,proto3,oneof"`
+}
+
+type DispatchClass_Operational struct {
+	Operational *Empty `protobuf:"bytes,2,opt,name=operational,proto3,oneof"`
+}
+
+type DispatchClass_Mandatory struct {
+	Mandatory *Empty `protobuf:"bytes,3,opt,name=mandatory,proto3,oneof"`
+}
+
+func (*DispatchClass_Normal) isDispatchClass_Variant() {}
+
+func (*DispatchClass_Operational) isDispatchClass_Variant() {}
+
+func (*DispatchClass_Mandatory) isDispatchClass_Variant() {}
+
+type DispatchInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weight  uint64         `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Class   *DispatchClass `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	PaysFee *Pays          `protobuf:"bytes,3,opt,name=pays_fee,json=paysFee,proto3" json:"pays_fee,omitempty"`
+}
+
+func (x *DispatchInfo) Reset() {
+	*x = DispatchInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchInfo) ProtoMessage() {}
+
+func (x *DispatchInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchInfo.ProtoReflect.Descriptor instead.
+func (*DispatchInfo) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DispatchInfo) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *DispatchInfo) GetClass() *DispatchClass {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+func (x *DispatchInfo) GetPaysFee() *Pays {
+	if x != nil {
+		return x.PaysFee
+	}
+	return nil
+}
+
+type EventRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event  *RuntimeEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Topics [][]byte      `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *EventRecord) Reset() {
+	*x = EventRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRecord) ProtoMessage() {}
+
+func (x *EventRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRecord.ProtoReflect.Descriptor instead.
+func (*EventRecord) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventRecord) GetEvent() *RuntimeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventRecord) GetTopics() [][]byte {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type FrameSystemPalletCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*FrameSystemPalletCall_Remark
+	Variant isFrameSystemPalletCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *FrameSystemPalletCall) Reset() {
+	*x = FrameSystemPalletCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSystemPalletCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSystemPalletCall) ProtoMessage() {}
+
+func (x *FrameSystemPalletCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSystemPalletCall.ProtoReflect.Descriptor instead.
+func (*FrameSystemPalletCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *FrameSystemPalletCall) GetVariant() isFrameSystemPalletCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletCall) GetRemark() []byte {
+	if x, ok := x.GetVariant().(*FrameSystemPalletCall_Remark); ok {
+		return x.Remark
+	}
+	return nil
+}
+
+type isFrameSystemPalletCall_Variant interface {
+	isFrameSystemPalletCall_Variant()
+}
+
+type FrameSystemPalletCall_Remark struct {
+	Remark []byte `protobuf:"bytes,1,opt,name=remark,proto3,oneof"`
+}
+
+func (*FrameSystemPalletCall_Remark) isFrameSystemPalletCall_Variant() {}
+
+type FrameSystemPalletEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*FrameSystemPalletEvent_ExtrinsicSuccess
+	//	*FrameSystemPalletEvent_Remarked
+	Variant isFrameSystemPalletEvent_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *FrameSystemPalletEvent) Reset() {
+	*x = FrameSystemPalletEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSystemPalletEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSystemPalletEvent) ProtoMessage() {}
+
+func (x *FrameSystemPalletEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSystemPalletEvent.ProtoReflect.Descriptor instead.
+func (*FrameSystemPalletEvent) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *FrameSystemPalletEvent) GetVariant() isFrameSystemPalletEvent_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletEvent) GetExtrinsicSuccess() *DispatchInfo {
+	if x, ok := x.GetVariant().(*FrameSystemPalletEvent_ExtrinsicSuccess); ok {
+		return x.ExtrinsicSuccess
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletEvent) GetRemarked() *FrameSystemPalletEvent_RemarkedFields {
+	if x, ok := x.GetVariant().(*FrameSystemPalletEvent_Remarked); ok {
+		return x.Remarked
+	}
+	return nil
+}
+
+type isFrameSystemPalletEvent_Variant interface {
+	isFrameSystemPalletEvent_Variant()
+}
+
+type FrameSystemPalletEvent_ExtrinsicSuccess struct {
+	ExtrinsicSuccess *DispatchInfo `protobuf:"bytes,1,opt,name=extrinsic_success,json=extrinsicSuccess,proto3,oneof"`
+}
+
+type FrameSystemPalletEvent_Remarked struct {
+	Remarked *FrameSystemPalletEvent_RemarkedFields `protobuf:"bytes,2,opt,name=remarked,proto3,oneof"`
+}
+
+func (*FrameSystemPalletEvent_ExtrinsicSuccess) isFrameSystemPalletEvent_Variant() {}
+
+func (*FrameSystemPalletEvent_Remarked) isFrameSystemPalletEvent_Variant() {}
+
+type MultiAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*MultiAddress_Id
+	//	*MultiAddress_Index
+	//	*MultiAddress_Raw
+	Variant isMultiAddress_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *MultiAddress) Reset() {
+	*x = MultiAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiAddress) ProtoMessage() {}
+
+func (x *MultiAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiAddress.ProtoReflect.Descriptor instead.
+func (*MultiAddress) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *MultiAddress) GetVariant() isMultiAddress_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *MultiAddress) GetId() []byte {
+	if x, ok := x.GetVariant().(*MultiAddress_Id); ok {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *MultiAddress) GetIndex() *Empty {
+	if x, ok := x.GetVariant().(*MultiAddress_Index); ok {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *MultiAddress) GetRaw() []byte {
+	if x, ok := x.GetVariant().(*MultiAddress_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+type isMultiAddress_Variant interface {
+	isMultiAddress_Variant()
+}
+
+type MultiAddress_Id struct {
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type MultiAddress_Index struct {
+	Index *Empty `protobuf:"bytes,2,opt,name=index,proto3,oneof"`
+}
+
+type MultiAddress_Raw struct {
+	Raw []byte `protobuf:"bytes,3,opt,name=raw,proto3,oneof"`
+}
+
+func (*MultiAddress_Id) isMultiAddress_Variant() {}
+
+func (*MultiAddress_Index) isMultiAddress_Variant() {}
+
+func (*MultiAddress_Raw) isMultiAddress_Variant() {}
+
+type MultiSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*MultiSignature_Ed25519
+	//	*MultiSignature_Sr25519
+	Variant isMultiSignature_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *MultiSignature) Reset() {
+	*x = MultiSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSignature) ProtoMessage() {}
+
+func (x *MultiSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSignature.ProtoReflect.Descriptor instead.
+func (*MultiSignature) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *MultiSignature) GetVariant() isMultiSignature_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *MultiSignature) GetEd25519() []byte {
+	if x, ok := x.GetVariant().(*MultiSignature_Ed25519); ok {
+		return x.Ed25519
+	}
+	return nil
+}
+
+func (x *MultiSignature) GetSr25519() []byte {
+	if x, ok := x.GetVariant().(*MultiSignature_Sr25519); ok {
+		return x.Sr25519
+	}
+	return nil
+}
+
+type isMultiSignature_Variant interface {
+	isMultiSignature_Variant()
+}
+
+type MultiSignature_Ed25519 struct {
+	Ed25519 []byte `protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
+}
+
+type MultiSignature_Sr25519 struct {
+	Sr25519 []byte `protobuf:"bytes,2,opt,name=sr25519,proto3,oneof"`
+}
+
+func (*MultiSignature_Ed25519) isMultiSignature_Variant() {}
+
+func (*MultiSignature_Sr25519) isMultiSignature_Variant() {}
+
+type Pays struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*Pays_Yes
+	//	*Pays_No
+	Variant isPays_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *Pays) Reset() {
+	*x = Pays{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pays) ProtoMessage() {}
+
+func (x *Pays) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pays.ProtoReflect.Descriptor instead.
+func (*Pays) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *Pays) GetVariant() isPays_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *Pays) GetYes() *Empty {
+	if x, ok := x.GetVariant().(*Pays_Yes); ok {
+		return x.Yes
+	}
+	return nil
+}
+
+func (x *Pays) GetNo() *Empty {
+	if x, ok := x.GetVariant().(*Pays_No); ok {
+		return x.No
+	}
+	return nil
+}
+
+type isPays_Variant interface {
+	isPays_Variant()
+}
+
+type Pays_Yes struct {
+	Yes *Empty `protobuf:"bytes,1,opt,name=yes,proto3,oneof"`
+}
+
+type Pays_No struct {
+	No *Empty `protobuf:"bytes,2,opt,name=no,proto3,oneof"`
+}
+
+func (*Pays_Yes) isPays_Variant() {}
+
+func (*Pays_No) isPays_Variant() {}
+
+type RuntimeCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*RuntimeCall_System
+	Variant isRuntimeCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *RuntimeCall) Reset() {
+	*x = RuntimeCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeCall) ProtoMessage() {}
+
+func (x *RuntimeCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeCall.ProtoReflect.Descriptor instead.
+func (*RuntimeCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *RuntimeCall) GetVariant() isRuntimeCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *RuntimeCall) GetSystem() *FrameSystemPalletCall {
+	if x, ok := x.GetVariant().(*RuntimeCall_System); ok {
+		return x.System
+	}
+	return nil
+}
+
+type isRuntimeCall_Variant interface {
+	isRuntimeCall_Variant()
+}
+
+type RuntimeCall_System struct {
+	System *FrameSystemPalletCall `protobuf:"bytes,1,opt,name=system,proto3,oneof"`
+}
+
+func (*RuntimeCall_System) isRuntimeCall_Variant() {}
+
+type RuntimeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*RuntimeEvent_System
+	Variant isRuntimeEvent_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *RuntimeEvent) Reset() {
+	*x = RuntimeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeEvent) ProtoMessage() {}
+
+func (x *RuntimeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeEvent.ProtoReflect.Descriptor instead.
+func (*RuntimeEvent) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *RuntimeEvent) GetVariant() isRuntimeEvent_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *RuntimeEvent) GetSystem() *FrameSystemPalletEvent {
+	if x, ok := x.GetVariant().(*RuntimeEvent_System); ok {
+		return x.System
+	}
+	return nil
+}
+
+type isRuntimeEvent_Variant interface {
+	isRuntimeEvent_Variant()
+}
+
+type RuntimeEvent_System struct {
+	System *FrameSystemPalletEvent `protobuf:"bytes,1,opt,name=system,proto3,oneof"`
+}
+
+func (*RuntimeEvent_System) isRuntimeEvent_Variant() {}
+
+type FrameSystemPalletEvent_RemarkedFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender []byte `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Hash   []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) Reset() {
+	*x = FrameSystemPalletEvent_RemarkedFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSystemPalletEvent_RemarkedFields) ProtoMessage() {}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSystemPalletEvent_RemarkedFields.ProtoReflect.Descriptor instead.
+func (*FrameSystemPalletEvent_RemarkedFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+var File_typespb_types_proto protoreflect.FileDescriptor
+
+var file_typespb_types_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x79, 0x70, 0x65, 0x73, 0x70, 0x62, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0e, 0x0a, 0x0c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xde, 0x01,
+	0x0a, 0x0d, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12,
+	0x3a, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x44, 0x0a, 0x0b, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x12, 0x40, 0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0xa2,
+	0x01, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3e, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x73, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x73, 0x52, 0x07, 0x70, 0x61, 0x79, 0x73,
+	0x46, 0x65, 0x65, 0x22, 0x64, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x3d, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x3c, 0x0a, 0x15, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61,
+	0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x42, 0x09, 0x0a, 0x07,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x16, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x56, 0x0a, 0x11, 0x65, 0x78, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x5f,
+	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x74, 0x72, 0x69, 0x6e,
+	0x73, 0x69, 0x63, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x5e, 0x0a, 0x08, 0x72, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x00,
+	0x52, 0x08, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x1a, 0x3c, 0x0a, 0x0e, 0x52, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x22, 0x79, 0x0a, 0x0c, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x12, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x03,
+	0x72, 0x61, 0x77, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x53,
+	0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x1a, 0x0a, 0x07, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x07, 0x65, 0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x12, 0x1a, 0x0a, 0x07,
+	0x73, 0x72, 0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x07, 0x73, 0x72, 0x32, 0x35, 0x35, 0x31, 0x39, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x22, 0x7b, 0x0a, 0x04, 0x50, 0x61, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x03, 0x79,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x03, 0x79, 0x65,
+	0x73, 0x12, 0x32, 0x0a, 0x02, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48,
+	0x00, 0x52, 0x02, 0x6e, 0x6f, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x22, 0x64, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12,
+	0x4a, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x30, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x72, 0x61, 0x6d,
+	0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c,
+	0x6c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x66, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x42, 0x1d,
+	0x5a, 0x1b, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_typespb_types_proto_rawDescOnce sync.Once
+	file_typespb_types_proto_rawDescData = file_typespb_types_proto_rawDesc
+)
+
+func file_typespb_types_proto_rawDescGZIP() []byte {
+	file_typespb_types_proto_rawDescOnce.Do(func() {
+		file_typespb_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_typespb_types_proto_rawDescData)
+	})
+	return file_typespb_types_proto_rawDescData
+}
+
+var file_typespb_types_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_typespb_types_proto_goTypes = []interface{}{
+	(*Empty)(nil),                                 // 0: example_com.minimal.types.Empty
+	(*CheckGenesis)(nil),                          // 1: example_com.minimal.types.CheckGenesis
+	(*CheckSpecVersion)(nil),                      // 2: example_com.minimal.types.CheckSpecVersion
+	(*DispatchClass)(nil),                         // 3: example_com.minimal.types.DispatchClass
+	(*DispatchInfo)(nil),                          // 4: example_com.minimal.types.DispatchInfo
+	(*EventRecord)(nil),                           // 5: example_com.minimal.types.EventRecord
+	(*FrameSystemPalletCall)(nil),                 // 6: example_com.minimal.types.FrameSystemPalletCall
+	(*FrameSystemPalletEvent)(nil),                // 7: example_com.minimal.types.FrameSystemPalletEvent
+	(*MultiAddress)(nil),                          // 8: example_com.minimal.types.MultiAddress
+	(*MultiSignature)(nil),                        // 9: example_com.minimal.types.MultiSignature
+	(*Pays)(nil),                                  // 10: example_com.minimal.types.Pays
+	(*RuntimeCall)(nil),                           // 11: example_com.minimal.types.RuntimeCall
+	(*RuntimeEvent)(nil),                          // 12: example_com.minimal.types.RuntimeEvent
+	(*FrameSystemPalletEvent_RemarkedFields)(nil), // 13: example_com.minimal.types.FrameSystemPalletEvent.RemarkedFields
+}
+var file_typespb_types_proto_depIdxs = []int32{
+	0,  // 0: example_com.minimal.types.DispatchClass.normal:type_name -> example_com.minimal.types.Empty
+	0,  // 1: example_com.minimal.types.DispatchClass.operational:type_name -> example_com.minimal.types.Empty
+	0,  // 2: example_com.minimal.types.DispatchClass.mandatory:type_name -> example_com.minimal.types.Empty
+	3,  // 3: example_com.minimal.types.DispatchInfo.class:type_name -> example_com.minimal.types.DispatchClass
+	10, // 4: example_com.minimal.types.DispatchInfo.pays_fee:type_name -> example_com.minimal.types.Pays
+	12, // 5: example_com.minimal.types.EventRecord.event:type_name -> example_com.minimal.types.RuntimeEvent
+	4,  // 6: example_com.minimal.types.FrameSystemPalletEvent.extrinsic_success:type_name -> example_com.minimal.types.DispatchInfo
+	13, // 7: example_com.minimal.types.FrameSystemPalletEvent.remarked:type_name -> example_com.minimal.types.FrameSystemPalletEvent.RemarkedFields
+	0,  // 8: example_com.minimal.types.MultiAddress.index:type_name -> example_com.minimal.types.Empty
+	0,  // 9: example_com.minimal.types.Pays.yes:type_name -> example_com.minimal.types.Empty
+	0,  // 10: example_com.minimal.types.Pays.no:type_name -> example_com.minimal.types.Empty
+	6,  // 11: example_com.minimal.types.RuntimeCall.system:type_name -> example_com.minimal.types.FrameSystemPalletCall
+	7,  // 12: example_com.minimal.types.RuntimeEvent.system:type_name -> example_com.minimal.types.FrameSystemPalletEvent
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_typespb_types_proto_init() }
+func file_typespb_types_proto_init() {
+	if File_typespb_types_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_typespb_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckGenesis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSpecVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSystemPalletCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSystemPalletEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSystemPalletEvent_RemarkedFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_typespb_types_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*DispatchClass_Normal)(nil),
+		(*DispatchClass_Operational)(nil),
+		(*DispatchClass_Mandatory)(nil),
+	}
+	file_typespb_types_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*FrameSystemPalletCall_Remark)(nil),
+	}
+	file_typespb_types_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*FrameSystemPalletEvent_ExtrinsicSuccess)(nil),
+		(*FrameSystemPalletEvent_Remarked)(nil),
+	}
+	file_typespb_types_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*MultiAddress_Id)(nil),
+		(*MultiAddress_Index)(nil),
+		(*MultiAddress_Raw)(nil),
+	}
+	file_typespb_types_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*MultiSignature_Ed25519)(nil),
+		(*MultiSignature_Sr25519)(nil),
+	}
+	file_typespb_types_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*Pays_Yes)(nil),
+		(*Pays_No)(nil),
+	}
+	file_typespb_types_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*RuntimeCall_System)(nil),
+	}
+	file_typespb_types_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*RuntimeEvent_System)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_typespb_types_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_typespb_types_proto_goTypes,
+		DependencyIndexes: file_typespb_types_proto_depIdxs,
+		MessageInfos:      file_typespb_types_proto_msgTypes,
+	}.Build()
+	File_typespb_types_proto = out.File
+	file_typespb_types_proto_rawDesc = nil
+	file_typespb_types_proto_goTypes = nil
+	file_typespb_types_proto_depIdxs = nil
+}
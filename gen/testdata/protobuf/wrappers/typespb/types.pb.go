@@ -0,0 +1,3082 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: typespb/types.proto
+
+package typespb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Empty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Empty) Reset() {
+	*x = Empty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Empty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Empty) ProtoMessage() {}
+
+func (x *Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Empty.ProtoReflect.Descriptor instead.
+func (*Empty) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{0}
+}
+
+type CheckGenesis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckGenesis) Reset() {
+	*x = CheckGenesis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckGenesis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckGenesis) ProtoMessage() {}
+
+func (x *CheckGenesis) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckGenesis.ProtoReflect.Descriptor instead.
+func (*CheckGenesis) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{1}
+}
+
+type CheckSpecVersion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckSpecVersion) Reset() {
+	*x = CheckSpecVersion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckSpecVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckSpecVersion) ProtoMessage() {}
+
+func (x *CheckSpecVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckSpecVersion.ProtoReflect.Descriptor instead.
+func (*CheckSpecVersion) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{2}
+}
+
+type DispatchClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*DispatchClass_Normal
+	//	*DispatchClass_Operational
+	//	*DispatchClass_Mandatory
+	Variant isDispatchClass_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *DispatchClass) Reset() {
+	*x = DispatchClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchClass) ProtoMessage() {}
+
+func (x *DispatchClass) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchClass.ProtoReflect.Descriptor instead.
+func (*DispatchClass) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *DispatchClass) GetVariant() isDispatchClass_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *DispatchClass) GetNormal() *Empty {
+	if x, ok := x.GetVariant().(*DispatchClass_Normal); ok {
+		return x.Normal
+	}
+	return nil
+}
+
+func (x *DispatchClass) GetOperational() *Empty {
+	if x, ok := x.GetVariant().(*DispatchClass_Operational); ok {
+		return x.Operational
+	}
+	return nil
+}
+
+func (x *DispatchClass) GetMandatory() *Empty {
+	if x, ok := x.GetVariant().(*DispatchClass_Mandatory); ok {
+		return x.Mandatory
+	}
+	return nil
+}
+
+type isDispatchClass_Variant interface {
+	isDispatchClass_Variant()
+}
+
+type DispatchClass_Normal struct {
+	Normal *Empty `protobuf:"bytes,1,opt,name=normal,proto3,oneof"`
+}
+
+type DispatchClass_Operational struct {
+	Operational *Empty `protobuf:"bytes,2,opt,name=operational,proto3,oneof"`
+}
+
+type DispatchClass_Mandatory struct {
+	Mandatory *Empty `protobuf:"bytes,3,opt,name=mandatory,proto3,oneof"`
+}
+
+func (*DispatchClass_Normal) isDispatchClass_Variant() {}
+
+func (*DispatchClass_Operational) isDispatchClass_Variant() {}
+
+func (*DispatchClass_Mandatory) isDispatchClass_Variant() {}
+
+type DispatchInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weight  uint64         `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Class   *DispatchClass `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	PaysFee *Pays          `protobuf:"bytes,3,opt,name=pays_fee,json=paysFee,proto3" json:"pays_fee,omitempty"`
+}
+
+func (x *DispatchInfo) Reset() {
+	*x = DispatchInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DispatchInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchInfo) ProtoMessage() {}
+
+func (x *DispatchInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchInfo.ProtoReflect.Descriptor instead.
+func (*DispatchInfo) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DispatchInfo) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *DispatchInfo) GetClass() *DispatchClass {
+	if x != nil {
+		return x.Class
+	}
+	return nil
+}
+
+func (x *DispatchInfo) GetPaysFee() *Pays {
+	if x != nil {
+		return x.PaysFee
+	}
+	return nil
+}
+
+type EventRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event  *RuntimeEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Topics [][]byte      `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+}
+
+func (x *EventRecord) Reset() {
+	*x = EventRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRecord) ProtoMessage() {}
+
+func (x *EventRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventRecord.ProtoReflect.Descriptor instead.
+func (*EventRecord) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventRecord) GetEvent() *RuntimeEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *EventRecord) GetTopics() [][]byte {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type FrameSystemPalletCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*FrameSystemPalletCall_Remark
+	Variant isFrameSystemPalletCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *FrameSystemPalletCall) Reset() {
+	*x = FrameSystemPalletCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSystemPalletCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSystemPalletCall) ProtoMessage() {}
+
+func (x *FrameSystemPalletCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSystemPalletCall.ProtoReflect.Descriptor instead.
+func (*FrameSystemPalletCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *FrameSystemPalletCall) GetVariant() isFrameSystemPalletCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletCall) GetRemark() []byte {
+	if x, ok := x.GetVariant().(*FrameSystemPalletCall_Remark); ok {
+		return x.Remark
+	}
+	return nil
+}
+
+type isFrameSystemPalletCall_Variant interface {
+	isFrameSystemPalletCall_Variant()
+}
+
+type FrameSystemPalletCall_Remark struct {
+	Remark []byte `protobuf:"bytes,1,opt,name=remark,proto3,oneof"`
+}
+
+func (*FrameSystemPalletCall_Remark) isFrameSystemPalletCall_Variant() {}
+
+type FrameSystemPalletEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*FrameSystemPalletEvent_ExtrinsicSuccess
+	//	*FrameSystemPalletEvent_Remarked
+	Variant isFrameSystemPalletEvent_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *FrameSystemPalletEvent) Reset() {
+	*x = FrameSystemPalletEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSystemPalletEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSystemPalletEvent) ProtoMessage() {}
+
+func (x *FrameSystemPalletEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSystemPalletEvent.ProtoReflect.Descriptor instead.
+func (*FrameSystemPalletEvent) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *FrameSystemPalletEvent) GetVariant() isFrameSystemPalletEvent_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletEvent) GetExtrinsicSuccess() *DispatchInfo {
+	if x, ok := x.GetVariant().(*FrameSystemPalletEvent_ExtrinsicSuccess); ok {
+		return x.ExtrinsicSuccess
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletEvent) GetRemarked() *FrameSystemPalletEvent_RemarkedFields {
+	if x, ok := x.GetVariant().(*FrameSystemPalletEvent_Remarked); ok {
+		return x.Remarked
+	}
+	return nil
+}
+
+type isFrameSystemPalletEvent_Variant interface {
+	isFrameSystemPalletEvent_Variant()
+}
+
+type FrameSystemPalletEvent_ExtrinsicSuccess struct {
+	ExtrinsicSuccess *DispatchInfo `protobuf:"bytes,1,opt,name=extrinsic_success,json=extrinsicSuccess,proto3,oneof"`
+}
+
+type FrameSystemPalletEvent_Remarked struct {
+	Remarked *FrameSystemPalletEvent_RemarkedFields `protobuf:"bytes,2,opt,name=remarked,proto3,oneof"`
+}
+
+func (*FrameSystemPalletEvent_ExtrinsicSuccess) isFrameSystemPalletEvent_Variant() {}
+
+func (*FrameSystemPalletEvent_Remarked) isFrameSystemPalletEvent_Variant() {}
+
+type MultiAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*MultiAddress_Id
+	//	*MultiAddress_Index
+	//	*MultiAddress_Raw
+	Variant isMultiAddress_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *MultiAddress) Reset() {
+	*x = MultiAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiAddress) ProtoMessage() {}
+
+func (x *MultiAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiAddress.ProtoReflect.Descriptor instead.
+func (*MultiAddress) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *MultiAddress) GetVariant() isMultiAddress_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *MultiAddress) GetId() []byte {
+	if x, ok := x.GetVariant().(*MultiAddress_Id); ok {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *MultiAddress) GetIndex() *Empty {
+	if x, ok := x.GetVariant().(*MultiAddress_Index); ok {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *MultiAddress) GetRaw() []byte {
+	if x, ok := x.GetVariant().(*MultiAddress_Raw); ok {
+		return x.Raw
+	}
+	return nil
+}
+
+type isMultiAddress_Variant interface {
+	isMultiAddress_Variant()
+}
+
+type MultiAddress_Id struct {
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type MultiAddress_Index struct {
+	Index *Empty `protobuf:"bytes,2,opt,name=index,proto3,oneof"`
+}
+
+type MultiAddress_Raw struct {
+	Raw []byte `protobuf:"bytes,3,opt,name=raw,proto3,oneof"`
+}
+
+func (*MultiAddress_Id) isMultiAddress_Variant() {}
+
+func (*MultiAddress_Index) isMultiAddress_Variant() {}
+
+func (*MultiAddress_Raw) isMultiAddress_Variant() {}
+
+type MultiSignature struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*MultiSignature_Ed25519
+	//	*MultiSignature_Sr25519
+	Variant isMultiSignature_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *MultiSignature) Reset() {
+	*x = MultiSignature{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiSignature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSignature) ProtoMessage() {}
+
+func (x *MultiSignature) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSignature.ProtoReflect.Descriptor instead.
+func (*MultiSignature) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (m *MultiSignature) GetVariant() isMultiSignature_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *MultiSignature) GetEd25519() []byte {
+	if x, ok := x.GetVariant().(*MultiSignature_Ed25519); ok {
+		return x.Ed25519
+	}
+	return nil
+}
+
+func (x *MultiSignature) GetSr25519() []byte {
+	if x, ok := x.GetVariant().(*MultiSignature_Sr25519); ok {
+		return x.Sr25519
+	}
+	return nil
+}
+
+type isMultiSignature_Variant interface {
+	isMultiSignature_Variant()
+}
+
+type MultiSignature_Ed25519 struct {
+	Ed25519 []byte `protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
+}
+
+type MultiSignature_Sr25519 struct {
+	Sr25519 []byte `protobuf:"bytes,2,opt,name=sr25519,proto3,oneof"`
+}
+
+func (*MultiSignature_Ed25519) isMultiSignature_Variant() {}
+
+func (*MultiSignature_Sr25519) isMultiSignature_Variant() {}
+
+type OptionTProxyType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*OptionTProxyType_None
+	//	*OptionTProxyType_Some
+	Variant isOptionTProxyType_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *OptionTProxyType) Reset() {
+	*x = OptionTProxyType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptionTProxyType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionTProxyType) ProtoMessage() {}
+
+func (x *OptionTProxyType) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionTProxyType.ProtoReflect.Descriptor instead.
+func (*OptionTProxyType) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *OptionTProxyType) GetVariant() isOptionTProxyType_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *OptionTProxyType) GetNone() *Empty {
+	if x, ok := x.GetVariant().(*OptionTProxyType_None); ok {
+		return x.None
+	}
+	return nil
+}
+
+func (x *OptionTProxyType) GetSome() *ProxyType {
+	if x, ok := x.GetVariant().(*OptionTProxyType_Some); ok {
+		return x.Some
+	}
+	return nil
+}
+
+type isOptionTProxyType_Variant interface {
+	isOptionTProxyType_Variant()
+}
+
+type OptionTProxyType_None struct {
+	None *Empty `protobuf:"bytes,1,opt,name=none,proto3,oneof"`
+}
+
+type OptionTProxyType_Some struct {
+	Some *ProxyType `protobuf:"bytes,2,opt,name=some,proto3,oneof"`
+}
+
+func (*OptionTProxyType_None) isOptionTProxyType_Variant() {}
+
+func (*OptionTProxyType_Some) isOptionTProxyType_Variant() {}
+
+type OptionTTimepoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*OptionTTimepoint_None
+	//	*OptionTTimepoint_Some
+	Variant isOptionTTimepoint_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *OptionTTimepoint) Reset() {
+	*x = OptionTTimepoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptionTTimepoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptionTTimepoint) ProtoMessage() {}
+
+func (x *OptionTTimepoint) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptionTTimepoint.ProtoReflect.Descriptor instead.
+func (*OptionTTimepoint) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *OptionTTimepoint) GetVariant() isOptionTTimepoint_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *OptionTTimepoint) GetNone() *Empty {
+	if x, ok := x.GetVariant().(*OptionTTimepoint_None); ok {
+		return x.None
+	}
+	return nil
+}
+
+func (x *OptionTTimepoint) GetSome() *Timepoint {
+	if x, ok := x.GetVariant().(*OptionTTimepoint_Some); ok {
+		return x.Some
+	}
+	return nil
+}
+
+type isOptionTTimepoint_Variant interface {
+	isOptionTTimepoint_Variant()
+}
+
+type OptionTTimepoint_None struct {
+	None *Empty `protobuf:"bytes,1,opt,name=none,proto3,oneof"`
+}
+
+type OptionTTimepoint_Some struct {
+	Some *Timepoint `protobuf:"bytes,2,opt,name=some,proto3,oneof"`
+}
+
+func (*OptionTTimepoint_None) isOptionTTimepoint_Variant() {}
+
+func (*OptionTTimepoint_Some) isOptionTTimepoint_Variant() {}
+
+type PalletMultisigPalletCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*PalletMultisigPalletCall_AsMultiThreshold1
+	//	*PalletMultisigPalletCall_AsMulti
+	Variant isPalletMultisigPalletCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *PalletMultisigPalletCall) Reset() {
+	*x = PalletMultisigPalletCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletMultisigPalletCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletMultisigPalletCall) ProtoMessage() {}
+
+func (x *PalletMultisigPalletCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletMultisigPalletCall.ProtoReflect.Descriptor instead.
+func (*PalletMultisigPalletCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *PalletMultisigPalletCall) GetVariant() isPalletMultisigPalletCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *PalletMultisigPalletCall) GetAsMultiThreshold1() *PalletMultisigPalletCall_AsMultiThreshold1Fields {
+	if x, ok := x.GetVariant().(*PalletMultisigPalletCall_AsMultiThreshold1); ok {
+		return x.AsMultiThreshold1
+	}
+	return nil
+}
+
+func (x *PalletMultisigPalletCall) GetAsMulti() *PalletMultisigPalletCall_AsMultiFields {
+	if x, ok := x.GetVariant().(*PalletMultisigPalletCall_AsMulti); ok {
+		return x.AsMulti
+	}
+	return nil
+}
+
+type isPalletMultisigPalletCall_Variant interface {
+	isPalletMultisigPalletCall_Variant()
+}
+
+type PalletMultisigPalletCall_AsMultiThreshold1 struct {
+	AsMultiThreshold1 *PalletMultisigPalletCall_AsMultiThreshold1Fields `protobuf:"bytes,1,opt,name=as_multi_threshold1,json=asMultiThreshold1,proto3,oneof"`
+}
+
+type PalletMultisigPalletCall_AsMulti struct {
+	AsMulti *PalletMultisigPalletCall_AsMultiFields `protobuf:"bytes,2,opt,name=as_multi,json=asMulti,proto3,oneof"`
+}
+
+func (*PalletMultisigPalletCall_AsMultiThreshold1) isPalletMultisigPalletCall_Variant() {}
+
+func (*PalletMultisigPalletCall_AsMulti) isPalletMultisigPalletCall_Variant() {}
+
+type PalletProxyPalletCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*PalletProxyPalletCall_Proxy
+	//	*PalletProxyPalletCall_ProxyAnnounced
+	Variant isPalletProxyPalletCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *PalletProxyPalletCall) Reset() {
+	*x = PalletProxyPalletCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletProxyPalletCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletProxyPalletCall) ProtoMessage() {}
+
+func (x *PalletProxyPalletCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletProxyPalletCall.ProtoReflect.Descriptor instead.
+func (*PalletProxyPalletCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (m *PalletProxyPalletCall) GetVariant() isPalletProxyPalletCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *PalletProxyPalletCall) GetProxy() *PalletProxyPalletCall_ProxyFields {
+	if x, ok := x.GetVariant().(*PalletProxyPalletCall_Proxy); ok {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *PalletProxyPalletCall) GetProxyAnnounced() *PalletProxyPalletCall_ProxyAnnouncedFields {
+	if x, ok := x.GetVariant().(*PalletProxyPalletCall_ProxyAnnounced); ok {
+		return x.ProxyAnnounced
+	}
+	return nil
+}
+
+type isPalletProxyPalletCall_Variant interface {
+	isPalletProxyPalletCall_Variant()
+}
+
+type PalletProxyPalletCall_Proxy struct {
+	Proxy *PalletProxyPalletCall_ProxyFields `protobuf:"bytes,1,opt,name=proxy,proto3,oneof"`
+}
+
+type PalletProxyPalletCall_ProxyAnnounced struct {
+	ProxyAnnounced *PalletProxyPalletCall_ProxyAnnouncedFields `protobuf:"bytes,2,opt,name=proxy_announced,json=proxyAnnounced,proto3,oneof"`
+}
+
+func (*PalletProxyPalletCall_Proxy) isPalletProxyPalletCall_Variant() {}
+
+func (*PalletProxyPalletCall_ProxyAnnounced) isPalletProxyPalletCall_Variant() {}
+
+type PalletSudoPalletCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*PalletSudoPalletCall_Sudo
+	//	*PalletSudoPalletCall_SudoUncheckedWeight
+	//	*PalletSudoPalletCall_SudoAs
+	Variant isPalletSudoPalletCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *PalletSudoPalletCall) Reset() {
+	*x = PalletSudoPalletCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletSudoPalletCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletSudoPalletCall) ProtoMessage() {}
+
+func (x *PalletSudoPalletCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletSudoPalletCall.ProtoReflect.Descriptor instead.
+func (*PalletSudoPalletCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (m *PalletSudoPalletCall) GetVariant() isPalletSudoPalletCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *PalletSudoPalletCall) GetSudo() *RuntimeCall {
+	if x, ok := x.GetVariant().(*PalletSudoPalletCall_Sudo); ok {
+		return x.Sudo
+	}
+	return nil
+}
+
+func (x *PalletSudoPalletCall) GetSudoUncheckedWeight() *PalletSudoPalletCall_SudoUncheckedWeightFields {
+	if x, ok := x.GetVariant().(*PalletSudoPalletCall_SudoUncheckedWeight); ok {
+		return x.SudoUncheckedWeight
+	}
+	return nil
+}
+
+func (x *PalletSudoPalletCall) GetSudoAs() *PalletSudoPalletCall_SudoAsFields {
+	if x, ok := x.GetVariant().(*PalletSudoPalletCall_SudoAs); ok {
+		return x.SudoAs
+	}
+	return nil
+}
+
+type isPalletSudoPalletCall_Variant interface {
+	isPalletSudoPalletCall_Variant()
+}
+
+type PalletSudoPalletCall_Sudo struct {
+	Sudo *RuntimeCall `protobuf:"bytes,1,opt,name=sudo,proto3,oneof"`
+}
+
+type PalletSudoPalletCall_SudoUncheckedWeight struct {
+	SudoUncheckedWeight *PalletSudoPalletCall_SudoUncheckedWeightFields `protobuf:"bytes,2,opt,name=sudo_unchecked_weight,json=sudoUncheckedWeight,proto3,oneof"`
+}
+
+type PalletSudoPalletCall_SudoAs struct {
+	SudoAs *PalletSudoPalletCall_SudoAsFields `protobuf:"bytes,3,opt,name=sudo_as,json=sudoAs,proto3,oneof"`
+}
+
+func (*PalletSudoPalletCall_Sudo) isPalletSudoPalletCall_Variant() {}
+
+func (*PalletSudoPalletCall_SudoUncheckedWeight) isPalletSudoPalletCall_Variant() {}
+
+func (*PalletSudoPalletCall_SudoAs) isPalletSudoPalletCall_Variant() {}
+
+type PalletUtilityPalletCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*PalletUtilityPalletCall_Batch
+	//	*PalletUtilityPalletCall_AsDerivative
+	//	*PalletUtilityPalletCall_BatchAll
+	//	*PalletUtilityPalletCall_ForceBatch
+	Variant isPalletUtilityPalletCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *PalletUtilityPalletCall) Reset() {
+	*x = PalletUtilityPalletCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletUtilityPalletCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletUtilityPalletCall) ProtoMessage() {}
+
+func (x *PalletUtilityPalletCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletUtilityPalletCall.ProtoReflect.Descriptor instead.
+func (*PalletUtilityPalletCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (m *PalletUtilityPalletCall) GetVariant() isPalletUtilityPalletCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *PalletUtilityPalletCall) GetBatch() *RuntimeCallSlice {
+	if x, ok := x.GetVariant().(*PalletUtilityPalletCall_Batch); ok {
+		return x.Batch
+	}
+	return nil
+}
+
+func (x *PalletUtilityPalletCall) GetAsDerivative() *PalletUtilityPalletCall_AsDerivativeFields {
+	if x, ok := x.GetVariant().(*PalletUtilityPalletCall_AsDerivative); ok {
+		return x.AsDerivative
+	}
+	return nil
+}
+
+func (x *PalletUtilityPalletCall) GetBatchAll() *RuntimeCallSlice {
+	if x, ok := x.GetVariant().(*PalletUtilityPalletCall_BatchAll); ok {
+		return x.BatchAll
+	}
+	return nil
+}
+
+func (x *PalletUtilityPalletCall) GetForceBatch() *RuntimeCallSlice {
+	if x, ok := x.GetVariant().(*PalletUtilityPalletCall_ForceBatch); ok {
+		return x.ForceBatch
+	}
+	return nil
+}
+
+type isPalletUtilityPalletCall_Variant interface {
+	isPalletUtilityPalletCall_Variant()
+}
+
+type PalletUtilityPalletCall_Batch struct {
+	Batch *RuntimeCallSlice `protobuf:"bytes,1,opt,name=batch,proto3,oneof"`
+}
+
+type PalletUtilityPalletCall_AsDerivative struct {
+	AsDerivative *PalletUtilityPalletCall_AsDerivativeFields `protobuf:"bytes,2,opt,name=as_derivative,json=asDerivative,proto3,oneof"`
+}
+
+type PalletUtilityPalletCall_BatchAll struct {
+	BatchAll *RuntimeCallSlice `protobuf:"bytes,3,opt,name=batch_all,json=batchAll,proto3,oneof"`
+}
+
+type PalletUtilityPalletCall_ForceBatch struct {
+	ForceBatch *RuntimeCallSlice `protobuf:"bytes,4,opt,name=force_batch,json=forceBatch,proto3,oneof"`
+}
+
+func (*PalletUtilityPalletCall_Batch) isPalletUtilityPalletCall_Variant() {}
+
+func (*PalletUtilityPalletCall_AsDerivative) isPalletUtilityPalletCall_Variant() {}
+
+func (*PalletUtilityPalletCall_BatchAll) isPalletUtilityPalletCall_Variant() {}
+
+func (*PalletUtilityPalletCall_ForceBatch) isPalletUtilityPalletCall_Variant() {}
+
+type Pays struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*Pays_Yes
+	//	*Pays_No
+	Variant isPays_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *Pays) Reset() {
+	*x = Pays{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pays) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pays) ProtoMessage() {}
+
+func (x *Pays) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pays.ProtoReflect.Descriptor instead.
+func (*Pays) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (m *Pays) GetVariant() isPays_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *Pays) GetYes() *Empty {
+	if x, ok := x.GetVariant().(*Pays_Yes); ok {
+		return x.Yes
+	}
+	return nil
+}
+
+func (x *Pays) GetNo() *Empty {
+	if x, ok := x.GetVariant().(*Pays_No); ok {
+		return x.No
+	}
+	return nil
+}
+
+type isPays_Variant interface {
+	isPays_Variant()
+}
+
+type Pays_Yes struct {
+	Yes *Empty `protobuf:"bytes,1,opt,name=yes,proto3,oneof"`
+}
+
+type Pays_No struct {
+	No *Empty `protobuf:"bytes,2,opt,name=no,proto3,oneof"`
+}
+
+func (*Pays_Yes) isPays_Variant() {}
+
+func (*Pays_No) isPays_Variant() {}
+
+type ProxyType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*ProxyType_Any
+	//	*ProxyType_NonTransfer
+	Variant isProxyType_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *ProxyType) Reset() {
+	*x = ProxyType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyType) ProtoMessage() {}
+
+func (x *ProxyType) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyType.ProtoReflect.Descriptor instead.
+func (*ProxyType) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *ProxyType) GetVariant() isProxyType_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *ProxyType) GetAny() *Empty {
+	if x, ok := x.GetVariant().(*ProxyType_Any); ok {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *ProxyType) GetNonTransfer() *Empty {
+	if x, ok := x.GetVariant().(*ProxyType_NonTransfer); ok {
+		return x.NonTransfer
+	}
+	return nil
+}
+
+type isProxyType_Variant interface {
+	isProxyType_Variant()
+}
+
+type ProxyType_Any struct {
+	Any *Empty `protobuf:"bytes,1,opt,name=any,proto3,oneof"`
+}
+
+type ProxyType_NonTransfer struct {
+	NonTransfer *Empty `protobuf:"bytes,2,opt,name=non_transfer,json=nonTransfer,proto3,oneof"`
+}
+
+func (*ProxyType_Any) isProxyType_Variant() {}
+
+func (*ProxyType_NonTransfer) isProxyType_Variant() {}
+
+type RuntimeCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*RuntimeCall_System
+	//	*RuntimeCall_Utility
+	//	*RuntimeCall_Sudo
+	//	*RuntimeCall_Proxy
+	//	*RuntimeCall_Multisig
+	Variant isRuntimeCall_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *RuntimeCall) Reset() {
+	*x = RuntimeCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeCall) ProtoMessage() {}
+
+func (x *RuntimeCall) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeCall.ProtoReflect.Descriptor instead.
+func (*RuntimeCall) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{18}
+}
+
+func (m *RuntimeCall) GetVariant() isRuntimeCall_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *RuntimeCall) GetSystem() *FrameSystemPalletCall {
+	if x, ok := x.GetVariant().(*RuntimeCall_System); ok {
+		return x.System
+	}
+	return nil
+}
+
+func (x *RuntimeCall) GetUtility() *PalletUtilityPalletCall {
+	if x, ok := x.GetVariant().(*RuntimeCall_Utility); ok {
+		return x.Utility
+	}
+	return nil
+}
+
+func (x *RuntimeCall) GetSudo() *PalletSudoPalletCall {
+	if x, ok := x.GetVariant().(*RuntimeCall_Sudo); ok {
+		return x.Sudo
+	}
+	return nil
+}
+
+func (x *RuntimeCall) GetProxy() *PalletProxyPalletCall {
+	if x, ok := x.GetVariant().(*RuntimeCall_Proxy); ok {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *RuntimeCall) GetMultisig() *PalletMultisigPalletCall {
+	if x, ok := x.GetVariant().(*RuntimeCall_Multisig); ok {
+		return x.Multisig
+	}
+	return nil
+}
+
+type isRuntimeCall_Variant interface {
+	isRuntimeCall_Variant()
+}
+
+type RuntimeCall_System struct {
+	System *FrameSystemPalletCall `protobuf:"bytes,1,opt,name=system,proto3,oneof"`
+}
+
+type RuntimeCall_Utility struct {
+	Utility *PalletUtilityPalletCall `protobuf:"bytes,2,opt,name=utility,proto3,oneof"`
+}
+
+type RuntimeCall_Sudo struct {
+	Sudo *PalletSudoPalletCall `protobuf:"bytes,3,opt,name=sudo,proto3,oneof"`
+}
+
+type RuntimeCall_Proxy struct {
+	Proxy *PalletProxyPalletCall `protobuf:"bytes,4,opt,name=proxy,proto3,oneof"`
+}
+
+type RuntimeCall_Multisig struct {
+	Multisig *PalletMultisigPalletCall `protobuf:"bytes,5,opt,name=multisig,proto3,oneof"`
+}
+
+func (*RuntimeCall_System) isRuntimeCall_Variant() {}
+
+func (*RuntimeCall_Utility) isRuntimeCall_Variant() {}
+
+func (*RuntimeCall_Sudo) isRuntimeCall_Variant() {}
+
+func (*RuntimeCall_Proxy) isRuntimeCall_Variant() {}
+
+func (*RuntimeCall_Multisig) isRuntimeCall_Variant() {}
+
+type RuntimeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Variant:
+	//	*RuntimeEvent_System
+	Variant isRuntimeEvent_Variant `protobuf_oneof:"variant"`
+}
+
+func (x *RuntimeEvent) Reset() {
+	*x = RuntimeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeEvent) ProtoMessage() {}
+
+func (x *RuntimeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeEvent.ProtoReflect.Descriptor instead.
+func (*RuntimeEvent) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{19}
+}
+
+func (m *RuntimeEvent) GetVariant() isRuntimeEvent_Variant {
+	if m != nil {
+		return m.Variant
+	}
+	return nil
+}
+
+func (x *RuntimeEvent) GetSystem() *FrameSystemPalletEvent {
+	if x, ok := x.GetVariant().(*RuntimeEvent_System); ok {
+		return x.System
+	}
+	return nil
+}
+
+type isRuntimeEvent_Variant interface {
+	isRuntimeEvent_Variant()
+}
+
+type RuntimeEvent_System struct {
+	System *FrameSystemPalletEvent `protobuf:"bytes,1,opt,name=system,proto3,oneof"`
+}
+
+func (*RuntimeEvent_System) isRuntimeEvent_Variant() {}
+
+type Timepoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint32 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Index  uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *Timepoint) Reset() {
+	*x = Timepoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timepoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timepoint) ProtoMessage() {}
+
+func (x *Timepoint) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timepoint.ProtoReflect.Descriptor instead.
+func (*Timepoint) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Timepoint) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Timepoint) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type WrapperKeepOpaque struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string       `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Field1 *RuntimeCall `protobuf:"bytes,2,opt,name=field1,proto3" json:"field1,omitempty"`
+}
+
+func (x *WrapperKeepOpaque) Reset() {
+	*x = WrapperKeepOpaque{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WrapperKeepOpaque) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WrapperKeepOpaque) ProtoMessage() {}
+
+func (x *WrapperKeepOpaque) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WrapperKeepOpaque.ProtoReflect.Descriptor instead.
+func (*WrapperKeepOpaque) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WrapperKeepOpaque) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *WrapperKeepOpaque) GetField1() *RuntimeCall {
+	if x != nil {
+		return x.Field1
+	}
+	return nil
+}
+
+type RuntimeCallSlice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*RuntimeCall `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *RuntimeCallSlice) Reset() {
+	*x = RuntimeCallSlice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeCallSlice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeCallSlice) ProtoMessage() {}
+
+func (x *RuntimeCallSlice) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeCallSlice.ProtoReflect.Descriptor instead.
+func (*RuntimeCallSlice) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RuntimeCallSlice) GetItems() []*RuntimeCall {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type FrameSystemPalletEvent_RemarkedFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sender []byte `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Hash   []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) Reset() {
+	*x = FrameSystemPalletEvent_RemarkedFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameSystemPalletEvent_RemarkedFields) ProtoMessage() {}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameSystemPalletEvent_RemarkedFields.ProtoReflect.Descriptor instead.
+func (*FrameSystemPalletEvent_RemarkedFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *FrameSystemPalletEvent_RemarkedFields) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type PalletMultisigPalletCall_AsMultiThreshold1Fields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OtherSignatories [][]byte     `protobuf:"bytes,1,rep,name=other_signatories,json=otherSignatories,proto3" json:"other_signatories,omitempty"`
+	Call             *RuntimeCall `protobuf:"bytes,2,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *PalletMultisigPalletCall_AsMultiThreshold1Fields) Reset() {
+	*x = PalletMultisigPalletCall_AsMultiThreshold1Fields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletMultisigPalletCall_AsMultiThreshold1Fields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletMultisigPalletCall_AsMultiThreshold1Fields) ProtoMessage() {}
+
+func (x *PalletMultisigPalletCall_AsMultiThreshold1Fields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletMultisigPalletCall_AsMultiThreshold1Fields.ProtoReflect.Descriptor instead.
+func (*PalletMultisigPalletCall_AsMultiThreshold1Fields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *PalletMultisigPalletCall_AsMultiThreshold1Fields) GetOtherSignatories() [][]byte {
+	if x != nil {
+		return x.OtherSignatories
+	}
+	return nil
+}
+
+func (x *PalletMultisigPalletCall_AsMultiThreshold1Fields) GetCall() *RuntimeCall {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+type PalletMultisigPalletCall_AsMultiFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Threshold        uint32             `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	OtherSignatories [][]byte           `protobuf:"bytes,2,rep,name=other_signatories,json=otherSignatories,proto3" json:"other_signatories,omitempty"`
+	MaybeTimepoint   *OptionTTimepoint  `protobuf:"bytes,3,opt,name=maybe_timepoint,json=maybeTimepoint,proto3" json:"maybe_timepoint,omitempty"`
+	Call             *WrapperKeepOpaque `protobuf:"bytes,4,opt,name=call,proto3" json:"call,omitempty"`
+	StoreCall        bool               `protobuf:"varint,5,opt,name=store_call,json=storeCall,proto3" json:"store_call,omitempty"`
+	MaxWeight        uint64             `protobuf:"varint,6,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+}
+
+func (x *PalletMultisigPalletCall_AsMultiFields) Reset() {
+	*x = PalletMultisigPalletCall_AsMultiFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletMultisigPalletCall_AsMultiFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletMultisigPalletCall_AsMultiFields) ProtoMessage() {}
+
+func (x *PalletMultisigPalletCall_AsMultiFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletMultisigPalletCall_AsMultiFields.ProtoReflect.Descriptor instead.
+func (*PalletMultisigPalletCall_AsMultiFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *PalletMultisigPalletCall_AsMultiFields) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *PalletMultisigPalletCall_AsMultiFields) GetOtherSignatories() [][]byte {
+	if x != nil {
+		return x.OtherSignatories
+	}
+	return nil
+}
+
+func (x *PalletMultisigPalletCall_AsMultiFields) GetMaybeTimepoint() *OptionTTimepoint {
+	if x != nil {
+		return x.MaybeTimepoint
+	}
+	return nil
+}
+
+func (x *PalletMultisigPalletCall_AsMultiFields) GetCall() *WrapperKeepOpaque {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *PalletMultisigPalletCall_AsMultiFields) GetStoreCall() bool {
+	if x != nil {
+		return x.StoreCall
+	}
+	return false
+}
+
+func (x *PalletMultisigPalletCall_AsMultiFields) GetMaxWeight() uint64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+type PalletProxyPalletCall_ProxyFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Real           *MultiAddress     `protobuf:"bytes,1,opt,name=real,proto3" json:"real,omitempty"`
+	ForceProxyType *OptionTProxyType `protobuf:"bytes,2,opt,name=force_proxy_type,json=forceProxyType,proto3" json:"force_proxy_type,omitempty"`
+	Call           *RuntimeCall      `protobuf:"bytes,3,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *PalletProxyPalletCall_ProxyFields) Reset() {
+	*x = PalletProxyPalletCall_ProxyFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletProxyPalletCall_ProxyFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletProxyPalletCall_ProxyFields) ProtoMessage() {}
+
+func (x *PalletProxyPalletCall_ProxyFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletProxyPalletCall_ProxyFields.ProtoReflect.Descriptor instead.
+func (*PalletProxyPalletCall_ProxyFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *PalletProxyPalletCall_ProxyFields) GetReal() *MultiAddress {
+	if x != nil {
+		return x.Real
+	}
+	return nil
+}
+
+func (x *PalletProxyPalletCall_ProxyFields) GetForceProxyType() *OptionTProxyType {
+	if x != nil {
+		return x.ForceProxyType
+	}
+	return nil
+}
+
+func (x *PalletProxyPalletCall_ProxyFields) GetCall() *RuntimeCall {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+type PalletProxyPalletCall_ProxyAnnouncedFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegate       *MultiAddress     `protobuf:"bytes,1,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Real           *MultiAddress     `protobuf:"bytes,2,opt,name=real,proto3" json:"real,omitempty"`
+	ForceProxyType *OptionTProxyType `protobuf:"bytes,3,opt,name=force_proxy_type,json=forceProxyType,proto3" json:"force_proxy_type,omitempty"`
+	Call           *RuntimeCall      `protobuf:"bytes,4,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *PalletProxyPalletCall_ProxyAnnouncedFields) Reset() {
+	*x = PalletProxyPalletCall_ProxyAnnouncedFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletProxyPalletCall_ProxyAnnouncedFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletProxyPalletCall_ProxyAnnouncedFields) ProtoMessage() {}
+
+func (x *PalletProxyPalletCall_ProxyAnnouncedFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletProxyPalletCall_ProxyAnnouncedFields.ProtoReflect.Descriptor instead.
+func (*PalletProxyPalletCall_ProxyAnnouncedFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{13, 1}
+}
+
+func (x *PalletProxyPalletCall_ProxyAnnouncedFields) GetDelegate() *MultiAddress {
+	if x != nil {
+		return x.Delegate
+	}
+	return nil
+}
+
+func (x *PalletProxyPalletCall_ProxyAnnouncedFields) GetReal() *MultiAddress {
+	if x != nil {
+		return x.Real
+	}
+	return nil
+}
+
+func (x *PalletProxyPalletCall_ProxyAnnouncedFields) GetForceProxyType() *OptionTProxyType {
+	if x != nil {
+		return x.ForceProxyType
+	}
+	return nil
+}
+
+func (x *PalletProxyPalletCall_ProxyAnnouncedFields) GetCall() *RuntimeCall {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+type PalletSudoPalletCall_SudoUncheckedWeightFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Call   *RuntimeCall `protobuf:"bytes,1,opt,name=call,proto3" json:"call,omitempty"`
+	Weight uint64       `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *PalletSudoPalletCall_SudoUncheckedWeightFields) Reset() {
+	*x = PalletSudoPalletCall_SudoUncheckedWeightFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletSudoPalletCall_SudoUncheckedWeightFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletSudoPalletCall_SudoUncheckedWeightFields) ProtoMessage() {}
+
+func (x *PalletSudoPalletCall_SudoUncheckedWeightFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletSudoPalletCall_SudoUncheckedWeightFields.ProtoReflect.Descriptor instead.
+func (*PalletSudoPalletCall_SudoUncheckedWeightFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *PalletSudoPalletCall_SudoUncheckedWeightFields) GetCall() *RuntimeCall {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *PalletSudoPalletCall_SudoUncheckedWeightFields) GetWeight() uint64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type PalletSudoPalletCall_SudoAsFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Who  *MultiAddress `protobuf:"bytes,1,opt,name=who,proto3" json:"who,omitempty"`
+	Call *RuntimeCall  `protobuf:"bytes,2,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *PalletSudoPalletCall_SudoAsFields) Reset() {
+	*x = PalletSudoPalletCall_SudoAsFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletSudoPalletCall_SudoAsFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletSudoPalletCall_SudoAsFields) ProtoMessage() {}
+
+func (x *PalletSudoPalletCall_SudoAsFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletSudoPalletCall_SudoAsFields.ProtoReflect.Descriptor instead.
+func (*PalletSudoPalletCall_SudoAsFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{14, 1}
+}
+
+func (x *PalletSudoPalletCall_SudoAsFields) GetWho() *MultiAddress {
+	if x != nil {
+		return x.Who
+	}
+	return nil
+}
+
+func (x *PalletSudoPalletCall_SudoAsFields) GetCall() *RuntimeCall {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+type PalletUtilityPalletCall_AsDerivativeFields struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint32       `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Call  *RuntimeCall `protobuf:"bytes,2,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *PalletUtilityPalletCall_AsDerivativeFields) Reset() {
+	*x = PalletUtilityPalletCall_AsDerivativeFields{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_typespb_types_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PalletUtilityPalletCall_AsDerivativeFields) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PalletUtilityPalletCall_AsDerivativeFields) ProtoMessage() {}
+
+func (x *PalletUtilityPalletCall_AsDerivativeFields) ProtoReflect() protoreflect.Message {
+	mi := &file_typespb_types_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PalletUtilityPalletCall_AsDerivativeFields.ProtoReflect.Descriptor instead.
+func (*PalletUtilityPalletCall_AsDerivativeFields) Descriptor() ([]byte, []int) {
+	return file_typespb_types_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *PalletUtilityPalletCall_AsDerivativeFields) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PalletUtilityPalletCall_AsDerivativeFields) GetCall() *RuntimeCall {
+	if x != nil {
+		return x.Call
+	}
+	return nil
+}
+
+var File_typespb_types_proto protoreflect.FileDescriptor
+
+var file_typespb_types_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x79, 0x70, 0x65, 0x73, 0x70, 0x62, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x22, 0x07, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0e, 0x0a, 0x0c, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe1,
+	0x01, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x12, 0x3b, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x45, 0x0a,
+	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d,
+	0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x09, 0x6d, 0x61, 0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x61,
+	0x6e, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x22, 0xa4, 0x01, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3f, 0x0a, 0x05, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x08,
+	0x70, 0x61, 0x79, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x73,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x73, 0x46, 0x65, 0x65, 0x22, 0x65, 0x0a, 0x0b, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x3e, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73,
+	0x22, 0x3c, 0x0a, 0x15, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x72, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x9b,
+	0x02, 0x0a, 0x16, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x57, 0x0a, 0x11, 0x65, 0x78, 0x74,
+	0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00,
+	0x52, 0x10, 0x65, 0x78, 0x74, 0x72, 0x69, 0x6e, 0x73, 0x69, 0x63, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x5f, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x64, 0x1a, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x7a, 0x0a, 0x0c,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x03, 0x72, 0x61, 0x77,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x03, 0x72, 0x61, 0x77, 0x42, 0x09, 0x0a,
+	0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x53, 0x0a, 0x0e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x65, 0x64,
+	0x32, 0x35, 0x35, 0x31, 0x39, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x65,
+	0x64, 0x32, 0x35, 0x35, 0x31, 0x39, 0x12, 0x1a, 0x0a, 0x07, 0x73, 0x72, 0x32, 0x35, 0x35, 0x31,
+	0x39, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x07, 0x73, 0x72, 0x32, 0x35, 0x35,
+	0x31, 0x39, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x93, 0x01,
+	0x0a, 0x10, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x37, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x73,
+	0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x48, 0x00, 0x52, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x10, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x54,
+	0x69, 0x6d, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x04, 0x6e, 0x6f, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x6e,
+	0x65, 0x12, 0x3b, 0x0a, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x04, 0x73, 0x6f, 0x6d, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0xc1, 0x05, 0x0a, 0x18, 0x50, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x7e, 0x0a, 0x13, 0x61, 0x73, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x31, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x4c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x41, 0x73, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x31, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x48, 0x00, 0x52, 0x11, 0x61, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x31, 0x12, 0x5f, 0x0a, 0x08, 0x61, 0x73, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x42, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x41,
+	0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x00, 0x52, 0x07,
+	0x61, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x1a, 0x83, 0x01, 0x0a, 0x17, 0x41, 0x73, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x31, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10,
+	0x6f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x3b, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x1a, 0xb2, 0x02,
+	0x0a, 0x0d, 0x41, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2b, 0x0a,
+	0x11, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x6f, 0x74, 0x68, 0x65, 0x72, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0f, 0x6d, 0x61,
+	0x79, 0x62, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x54, 0x69, 0x6d, 0x65, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x0e, 0x6d, 0x61, 0x79, 0x62, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x41, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x57, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x65, 0x70, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65, 0x52, 0x04,
+	0x63, 0x61, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x61,
+	0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43,
+	0x61, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x81, 0x06,
+	0x0a, 0x15, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x55, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x50,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x71,
+	0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x48,
+	0x00, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x64, 0x1a, 0xe0, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x3c, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x04, 0x72, 0x65, 0x61, 0x6c, 0x12,
+	0x56, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x04,
+	0x63, 0x61, 0x6c, 0x6c, 0x1a, 0xaf, 0x02, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x41, 0x6e,
+	0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x44, 0x0a,
+	0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72,
+	0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x72, 0x65, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x04, 0x72, 0x65, 0x61,
+	0x6c, 0x12, 0x56, 0x0a, 0x10, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x63, 0x61, 0x6c,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c,
+	0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x22, 0xb9, 0x04, 0x0a, 0x14, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x75, 0x64, 0x6f,
+	0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x3d, 0x0a, 0x04, 0x73, 0x75,
+	0x64, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c,
+	0x6c, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x64, 0x6f, 0x12, 0x80, 0x01, 0x0a, 0x15, 0x73, 0x75,
+	0x64, 0x6f, 0x5f, 0x75, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x75, 0x64,
+	0x6f, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x53, 0x75, 0x64, 0x6f,
+	0x55, 0x6e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x00, 0x52, 0x13, 0x73, 0x75, 0x64, 0x6f, 0x55, 0x6e, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x58, 0x0a, 0x07,
+	0x73, 0x75, 0x64, 0x6f, 0x5f, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70,
+	0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x53, 0x75, 0x64, 0x6f, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x2e,
+	0x53, 0x75, 0x64, 0x6f, 0x41, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x00, 0x52, 0x06,
+	0x73, 0x75, 0x64, 0x6f, 0x41, 0x73, 0x1a, 0x70, 0x0a, 0x19, 0x53, 0x75, 0x64, 0x6f, 0x55, 0x6e,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x87, 0x01, 0x0a, 0x0c, 0x53, 0x75, 0x64,
+	0x6f, 0x41, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x03, 0x77, 0x68, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x03, 0x77, 0x68, 0x6f, 0x12, 0x3b, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x04, 0x63, 0x61,
+	0x6c, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0xe0, 0x03,
+	0x0a, 0x17, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x50,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x44, 0x0a, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c,
+	0x6c, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x05, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x6d, 0x0a, 0x0d, 0x61, 0x73, 0x5f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x46, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x41, 0x73, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x48, 0x00,
+	0x52, 0x0c, 0x61, 0x73, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x4b,
+	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x48,
+	0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x41, 0x6c, 0x6c, 0x12, 0x4f, 0x0a, 0x0b, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x48, 0x00,
+	0x52, 0x0a, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x1a, 0x67, 0x0a, 0x12,
+	0x41, 0x73, 0x44, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3b, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x04, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x22, 0x7d, 0x0a, 0x04, 0x50, 0x61, 0x79, 0x73, 0x12, 0x35, 0x0a, 0x03, 0x79, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x03, 0x79, 0x65, 0x73, 0x12,
+	0x33, 0x0a, 0x02, 0x6e, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00,
+	0x52, 0x02, 0x6e, 0x6f, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22,
+	0x95, 0x01, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a,
+	0x03, 0x61, 0x6e, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52,
+	0x03, 0x61, 0x6e, 0x79, 0x12, 0x46, 0x0a, 0x0c, 0x6e, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52,
+	0x0b, 0x6e, 0x6f, 0x6e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x09, 0x0a, 0x07,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x9d, 0x03, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x4b, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x06, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x12, 0x4f, 0x0a, 0x07, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x07, 0x75, 0x74,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x04, 0x73, 0x75, 0x64, 0x6f, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x53, 0x75, 0x64, 0x6f, 0x50, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x73, 0x75, 0x64, 0x6f, 0x12, 0x49, 0x0a,
+	0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x48,
+	0x00, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x52, 0x0a, 0x08, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x73, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x61, 0x6c, 0x6c,
+	0x48, 0x00, 0x52, 0x08, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x73, 0x69, 0x67, 0x42, 0x09, 0x0a, 0x07,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x0c, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x50, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x22, 0x39, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x6a, 0x0a, 0x11, 0x57,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x4b, 0x65, 0x65, 0x70, 0x4f, 0x70, 0x61, 0x71, 0x75, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x31,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x31, 0x22, 0x51, 0x0a, 0x10, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
+	0x73, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x43,
+	0x61, 0x6c, 0x6c, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x1e, 0x5a, 0x1c, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_typespb_types_proto_rawDescOnce sync.Once
+	file_typespb_types_proto_rawDescData = file_typespb_types_proto_rawDesc
+)
+
+func file_typespb_types_proto_rawDescGZIP() []byte {
+	file_typespb_types_proto_rawDescOnce.Do(func() {
+		file_typespb_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_typespb_types_proto_rawDescData)
+	})
+	return file_typespb_types_proto_rawDescData
+}
+
+var file_typespb_types_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_typespb_types_proto_goTypes = []interface{}{
+	(*Empty)(nil),                                            // 0: example_com.wrappers.types.Empty
+	(*CheckGenesis)(nil),                                     // 1: example_com.wrappers.types.CheckGenesis
+	(*CheckSpecVersion)(nil),                                 // 2: example_com.wrappers.types.CheckSpecVersion
+	(*DispatchClass)(nil),                                    // 3: example_com.wrappers.types.DispatchClass
+	(*DispatchInfo)(nil),                                     // 4: example_com.wrappers.types.DispatchInfo
+	(*EventRecord)(nil),                                      // 5: example_com.wrappers.types.EventRecord
+	(*FrameSystemPalletCall)(nil),                            // 6: example_com.wrappers.types.FrameSystemPalletCall
+	(*FrameSystemPalletEvent)(nil),                           // 7: example_com.wrappers.types.FrameSystemPalletEvent
+	(*MultiAddress)(nil),                                     // 8: example_com.wrappers.types.MultiAddress
+	(*MultiSignature)(nil),                                   // 9: example_com.wrappers.types.MultiSignature
+	(*OptionTProxyType)(nil),                                 // 10: example_com.wrappers.types.OptionTProxyType
+	(*OptionTTimepoint)(nil),                                 // 11: example_com.wrappers.types.OptionTTimepoint
+	(*PalletMultisigPalletCall)(nil),                         // 12: example_com.wrappers.types.PalletMultisigPalletCall
+	(*PalletProxyPalletCall)(nil),                            // 13: example_com.wrappers.types.PalletProxyPalletCall
+	(*PalletSudoPalletCall)(nil),                             // 14: example_com.wrappers.types.PalletSudoPalletCall
+	(*PalletUtilityPalletCall)(nil),                          // 15: example_com.wrappers.types.PalletUtilityPalletCall
+	(*Pays)(nil),                                             // 16: example_com.wrappers.types.Pays
+	(*ProxyType)(nil),                                        // 17: example_com.wrappers.types.ProxyType
+	(*RuntimeCall)(nil),                                      // 18: example_com.wrappers.types.RuntimeCall
+	(*RuntimeEvent)(nil),                                     // 19: example_com.wrappers.types.RuntimeEvent
+	(*Timepoint)(nil),                                        // 20: example_com.wrappers.types.Timepoint
+	(*WrapperKeepOpaque)(nil),                                // 21: example_com.wrappers.types.WrapperKeepOpaque
+	(*RuntimeCallSlice)(nil),                                 // 22: example_com.wrappers.types.RuntimeCallSlice
+	(*FrameSystemPalletEvent_RemarkedFields)(nil),            // 23: example_com.wrappers.types.FrameSystemPalletEvent.RemarkedFields
+	(*PalletMultisigPalletCall_AsMultiThreshold1Fields)(nil), // 24: example_com.wrappers.types.PalletMultisigPalletCall.AsMultiThreshold1Fields
+	(*PalletMultisigPalletCall_AsMultiFields)(nil),           // 25: example_com.wrappers.types.PalletMultisigPalletCall.AsMultiFields
+	(*PalletProxyPalletCall_ProxyFields)(nil),                // 26: example_com.wrappers.types.PalletProxyPalletCall.ProxyFields
+	(*PalletProxyPalletCall_ProxyAnnouncedFields)(nil),       // 27: example_com.wrappers.types.PalletProxyPalletCall.ProxyAnnouncedFields
+	(*PalletSudoPalletCall_SudoUncheckedWeightFields)(nil),   // 28: example_com.wrappers.types.PalletSudoPalletCall.SudoUncheckedWeightFields
+	(*PalletSudoPalletCall_SudoAsFields)(nil),                // 29: example_com.wrappers.types.PalletSudoPalletCall.SudoAsFields
+	(*PalletUtilityPalletCall_AsDerivativeFields)(nil),       // 30: example_com.wrappers.types.PalletUtilityPalletCall.AsDerivativeFields
+}
+var file_typespb_types_proto_depIdxs = []int32{
+	0,  // 0: example_com.wrappers.types.DispatchClass.normal:type_name -> example_com.wrappers.types.Empty
+	0,  // 1: example_com.wrappers.types.DispatchClass.operational:type_name -> example_com.wrappers.types.Empty
+	0,  // 2: example_com.wrappers.types.DispatchClass.mandatory:type_name -> example_com.wrappers.types.Empty
+	3,  // 3: example_com.wrappers.types.DispatchInfo.class:type_name -> example_com.wrappers.types.DispatchClass
+	16, // 4: example_com.wrappers.types.DispatchInfo.pays_fee:type_name -> example_com.wrappers.types.Pays
+	19, // 5: example_com.wrappers.types.EventRecord.event:type_name -> example_com.wrappers.types.RuntimeEvent
+	4,  // 6: example_com.wrappers.types.FrameSystemPalletEvent.extrinsic_success:type_name -> example_com.wrappers.types.DispatchInfo
+	23, // 7: example_com.wrappers.types.FrameSystemPalletEvent.remarked:type_name -> example_com.wrappers.types.FrameSystemPalletEvent.RemarkedFields
+	0,  // 8: example_com.wrappers.types.MultiAddress.index:type_name -> example_com.wrappers.types.Empty
+	0,  // 9: example_com.wrappers.types.OptionTProxyType.none:type_name -> example_com.wrappers.types.Empty
+	17, // 10: example_com.wrappers.types.OptionTProxyType.some:type_name -> example_com.wrappers.types.ProxyType
+	0,  // 11: example_com.wrappers.types.OptionTTimepoint.none:type_name -> example_com.wrappers.types.Empty
+	20, // 12: example_com.wrappers.types.OptionTTimepoint.some:type_name -> example_com.wrappers.types.Timepoint
+	24, // 13: example_com.wrappers.types.PalletMultisigPalletCall.as_multi_threshold1:type_name -> example_com.wrappers.types.PalletMultisigPalletCall.AsMultiThreshold1Fields
+	25, // 14: example_com.wrappers.types.PalletMultisigPalletCall.as_multi:type_name -> example_com.wrappers.types.PalletMultisigPalletCall.AsMultiFields
+	26, // 15: example_com.wrappers.types.PalletProxyPalletCall.proxy:type_name -> example_com.wrappers.types.PalletProxyPalletCall.ProxyFields
+	27, // 16: example_com.wrappers.types.PalletProxyPalletCall.proxy_announced:type_name -> example_com.wrappers.types.PalletProxyPalletCall.ProxyAnnouncedFields
+	18, // 17: example_com.wrappers.types.PalletSudoPalletCall.sudo:type_name -> example_com.wrappers.types.RuntimeCall
+	28, // 18: example_com.wrappers.types.PalletSudoPalletCall.sudo_unchecked_weight:type_name -> example_com.wrappers.types.PalletSudoPalletCall.SudoUncheckedWeightFields
+	29, // 19: example_com.wrappers.types.PalletSudoPalletCall.sudo_as:type_name -> example_com.wrappers.types.PalletSudoPalletCall.SudoAsFields
+	22, // 20: example_com.wrappers.types.PalletUtilityPalletCall.batch:type_name -> example_com.wrappers.types.RuntimeCallSlice
+	30, // 21: example_com.wrappers.types.PalletUtilityPalletCall.as_derivative:type_name -> example_com.wrappers.types.PalletUtilityPalletCall.AsDerivativeFields
+	22, // 22: example_com.wrappers.types.PalletUtilityPalletCall.batch_all:type_name -> example_com.wrappers.types.RuntimeCallSlice
+	22, // 23: example_com.wrappers.types.PalletUtilityPalletCall.force_batch:type_name -> example_com.wrappers.types.RuntimeCallSlice
+	0,  // 24: example_com.wrappers.types.Pays.yes:type_name -> example_com.wrappers.types.Empty
+	0,  // 25: example_com.wrappers.types.Pays.no:type_name -> example_com.wrappers.types.Empty
+	0,  // 26: example_com.wrappers.types.ProxyType.any:type_name -> example_com.wrappers.types.Empty
+	0,  // 27: example_com.wrappers.types.ProxyType.non_transfer:type_name -> example_com.wrappers.types.Empty
+	6,  // 28: example_com.wrappers.types.RuntimeCall.system:type_name -> example_com.wrappers.types.FrameSystemPalletCall
+	15, // 29: example_com.wrappers.types.RuntimeCall.utility:type_name -> example_com.wrappers.types.PalletUtilityPalletCall
+	14, // 30: example_com.wrappers.types.RuntimeCall.sudo:type_name -> example_com.wrappers.types.PalletSudoPalletCall
+	13, // 31: example_com.wrappers.types.RuntimeCall.proxy:type_name -> example_com.wrappers.types.PalletProxyPalletCall
+	12, // 32: example_com.wrappers.types.RuntimeCall.multisig:type_name -> example_com.wrappers.types.PalletMultisigPalletCall
+	7,  // 33: example_com.wrappers.types.RuntimeEvent.system:type_name -> example_com.wrappers.types.FrameSystemPalletEvent
+	18, // 34: example_com.wrappers.types.WrapperKeepOpaque.field1:type_name -> example_com.wrappers.types.RuntimeCall
+	18, // 35: example_com.wrappers.types.RuntimeCallSlice.items:type_name -> example_com.wrappers.types.RuntimeCall
+	18, // 36: example_com.wrappers.types.PalletMultisigPalletCall.AsMultiThreshold1Fields.call:type_name -> example_com.wrappers.types.RuntimeCall
+	11, // 37: example_com.wrappers.types.PalletMultisigPalletCall.AsMultiFields.maybe_timepoint:type_name -> example_com.wrappers.types.OptionTTimepoint
+	21, // 38: example_com.wrappers.types.PalletMultisigPalletCall.AsMultiFields.call:type_name -> example_com.wrappers.types.WrapperKeepOpaque
+	8,  // 39: example_com.wrappers.types.PalletProxyPalletCall.ProxyFields.real:type_name -> example_com.wrappers.types.MultiAddress
+	10, // 40: example_com.wrappers.types.PalletProxyPalletCall.ProxyFields.force_proxy_type:type_name -> example_com.wrappers.types.OptionTProxyType
+	18, // 41: example_com.wrappers.types.PalletProxyPalletCall.ProxyFields.call:type_name -> example_com.wrappers.types.RuntimeCall
+	8,  // 42: example_com.wrappers.types.PalletProxyPalletCall.ProxyAnnouncedFields.delegate:type_name -> example_com.wrappers.types.MultiAddress
+	8,  // 43: example_com.wrappers.types.PalletProxyPalletCall.ProxyAnnouncedFields.real:type_name -> example_com.wrappers.types.MultiAddress
+	10, // 44: example_com.wrappers.types.PalletProxyPalletCall.ProxyAnnouncedFields.force_proxy_type:type_name -> example_com.wrappers.types.OptionTProxyType
+	18, // 45: example_com.wrappers.types.PalletProxyPalletCall.ProxyAnnouncedFields.call:type_name -> example_com.wrappers.types.RuntimeCall
+	18, // 46: example_com.wrappers.types.PalletSudoPalletCall.SudoUncheckedWeightFields.call:type_name -> example_com.wrappers.types.RuntimeCall
+	8,  // 47: example_com.wrappers.types.PalletSudoPalletCall.SudoAsFields.who:type_name -> example_com.wrappers.types.MultiAddress
+	18, // 48: example_com.wrappers.types.PalletSudoPalletCall.SudoAsFields.call:type_name -> example_com.wrappers.types.RuntimeCall
+	18, // 49: example_com.wrappers.types.PalletUtilityPalletCall.AsDerivativeFields.call:type_name -> example_com.wrappers.types.RuntimeCall
+	50, // [50:50] is the sub-list for method output_type
+	50, // [50:50] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
+}
+
+func init() { file_typespb_types_proto_init() }
+func file_typespb_types_proto_init() {
+	if File_typespb_types_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_typespb_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Empty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckGenesis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSpecVersion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DispatchInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSystemPalletCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSystemPalletEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptionTProxyType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptionTTimepoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletMultisigPalletCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletProxyPalletCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletSudoPalletCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletUtilityPalletCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pays); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timepoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WrapperKeepOpaque); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeCallSlice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameSystemPalletEvent_RemarkedFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletMultisigPalletCall_AsMultiThreshold1Fields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletMultisigPalletCall_AsMultiFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletProxyPalletCall_ProxyFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletProxyPalletCall_ProxyAnnouncedFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletSudoPalletCall_SudoUncheckedWeightFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletSudoPalletCall_SudoAsFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_typespb_types_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PalletUtilityPalletCall_AsDerivativeFields); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_typespb_types_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*DispatchClass_Normal)(nil),
+		(*DispatchClass_Operational)(nil),
+		(*DispatchClass_Mandatory)(nil),
+	}
+	file_typespb_types_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*FrameSystemPalletCall_Remark)(nil),
+	}
+	file_typespb_types_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*FrameSystemPalletEvent_ExtrinsicSuccess)(nil),
+		(*FrameSystemPalletEvent_Remarked)(nil),
+	}
+	file_typespb_types_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*MultiAddress_Id)(nil),
+		(*MultiAddress_Index)(nil),
+		(*MultiAddress_Raw)(nil),
+	}
+	file_typespb_types_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*MultiSignature_Ed25519)(nil),
+		(*MultiSignature_Sr25519)(nil),
+	}
+	file_typespb_types_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*OptionTProxyType_None)(nil),
+		(*OptionTProxyType_Some)(nil),
+	}
+	file_typespb_types_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*OptionTTimepoint_None)(nil),
+		(*OptionTTimepoint_Some)(nil),
+	}
+	file_typespb_types_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*PalletMultisigPalletCall_AsMultiThreshold1)(nil),
+		(*PalletMultisigPalletCall_AsMulti)(nil),
+	}
+	file_typespb_types_proto_msgTypes[13].OneofWrappers = []interface{}{
+		(*PalletProxyPalletCall_Proxy)(nil),
+		(*PalletProxyPalletCall_ProxyAnnounced)(nil),
+	}
+	file_typespb_types_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*PalletSudoPalletCall_Sudo)(nil),
+		(*PalletSudoPalletCall_SudoUncheckedWeight)(nil),
+		(*PalletSudoPalletCall_SudoAs)(nil),
+	}
+	file_typespb_types_proto_msgTypes[15].OneofWrappers = []interface{}{
+		(*PalletUtilityPalletCall_Batch)(nil),
+		(*PalletUtilityPalletCall_AsDerivative)(nil),
+		(*PalletUtilityPalletCall_BatchAll)(nil),
+		(*PalletUtilityPalletCall_ForceBatch)(nil),
+	}
+	file_typespb_types_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*Pays_Yes)(nil),
+		(*Pays_No)(nil),
+	}
+	file_typespb_types_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*ProxyType_Any)(nil),
+		(*ProxyType_NonTransfer)(nil),
+	}
+	file_typespb_types_proto_msgTypes[18].OneofWrappers = []interface{}{
+		(*RuntimeCall_System)(nil),
+		(*RuntimeCall_Utility)(nil),
+		(*RuntimeCall_Sudo)(nil),
+		(*RuntimeCall_Proxy)(nil),
+		(*RuntimeCall_Multisig)(nil),
+	}
+	file_typespb_types_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*RuntimeEvent_System)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_typespb_types_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   31,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_typespb_types_proto_goTypes,
+		DependencyIndexes: file_typespb_types_proto_depIdxs,
+		MessageInfos:      file_typespb_types_proto_msgTypes,
+	}.Build()
+	File_typespb_types_proto = out.File
+	file_typespb_types_proto_rawDesc = nil
+	file_typespb_types_proto_goTypes = nil
+	file_typespb_types_proto_depIdxs = nil
+}
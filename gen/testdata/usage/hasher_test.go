@@ -0,0 +1,60 @@
+// The generated hasher is a copy of the metahash package's, so they must hash metadata the same
+// way. TestBuildGenerated copies this into the types package of every fixture, as
+// types/hasher_test.go, since the hasher isn't exported.
+package types
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/metahash"
+	gsrpctypes "github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Check that the generated hasher gives metahash's hashes of a metadata
+func checkHashes(t *testing.T, meta *gsrpctypes.MetadataV14) {
+	t.Helper()
+	want, err := metahash.Hash(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := hashMetadata(meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("hashed %v pallets, expected %v", len(got), len(want))
+	}
+	for name, w := range want {
+		g := got[name]
+		if !reflect.DeepEqual(g.Calls, w.Calls) || !reflect.DeepEqual(g.Storage, w.Storage) || !reflect.DeepEqual(g.Events, w.Events) {
+			t.Fatalf("hashes of pallet %v are %+v, expected %+v", name, g, w)
+		}
+	}
+}
+
+func TestHashMetadata(t *testing.T) {
+	// go-substrate-rpc-client's example metadata, of a full node runtime
+	var example gsrpctypes.Metadata
+	if err := codec.DecodeFromHex(gsrpctypes.MetadataV14Data, &example); err != nil {
+		t.Fatal(err)
+	}
+	checkHashes(t, &example.AsMetadataV14)
+
+	// The metadata the code was generated from, whose hashes are embedded
+	checkHashes(t, &Meta.AsMetadataV14)
+	if len(metadataHashes) == 0 {
+		t.Fatal("no hashes are embedded")
+	}
+	want, err := metahash.Hash(&Meta.AsMetadataV14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, w := range want {
+		g := metadataHashes[name]
+		if !reflect.DeepEqual(g.Calls, w.Calls) || !reflect.DeepEqual(g.Storage, w.Storage) || !reflect.DeepEqual(g.Events, w.Events) {
+			t.Fatalf("embedded hashes of pallet %v are %+v, expected %+v", name, g, w)
+		}
+	}
+}
@@ -0,0 +1,689 @@
+// Package usage tests the code generated for the kinds fixture like a user would. TestBuildGenerated
+// copies it into the generated module, as usage/usage_test.go.
+package usage
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"example.com/kinds/chaintest"
+	"example.com/kinds/kinds"
+	"example.com/kinds/protoconv"
+	"example.com/kinds/system"
+	kindstypes "example.com/kinds/types"
+	"example.com/kinds/typespb"
+	"github.com/aphoh/go-substrate-gen/mocknode"
+	gsrpc "github.com/centrifuge/go-substrate-rpc-client/v4"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+func TestChainTest(t *testing.T) {
+	state := chaintest.NewState()
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Without values, getters return the defaults
+	counter, err := kinds.GetCounterLatest(state)
+	check(err)
+	if counter != 0 {
+		t.Fatalf("default counter is %v", counter)
+	}
+	alice := [32]byte{1}
+	_, isSome, err := kinds.GetBlake2128ConcatLatest(state, alice)
+	check(err)
+	if isSome {
+		t.Fatal("account of alice exists before it's set")
+	}
+
+	account := kindstypes.AccountData{Free: types.NewU128(*big.NewInt(100)), Flags: 3}
+	check(chaintest.SetKindsCounter(state, 5))
+	check(chaintest.SetKindsBlake2128Concat(state, alice, account))
+	block1 := types.Hash{1}
+	state.Commit(block1)
+	check(chaintest.SetKindsCounter(state, 6))
+	block2 := types.Hash{2}
+	state.Commit(block2)
+
+	// Blocks are snapshots
+	counter, err = kinds.GetCounter(state, block1)
+	check(err)
+	if counter != 5 {
+		t.Fatalf("counter at block 1 is %v", counter)
+	}
+	counter, err = kinds.GetCounterLatest(state)
+	check(err)
+	if counter != 6 {
+		t.Fatalf("latest counter is %v", counter)
+	}
+	if _, err := kinds.GetCounter(state, types.Hash{9}); err == nil {
+		t.Fatal("read a block that was never committed")
+	}
+
+	accounts, isSomes, err := kinds.GetBlake2128ConcatMulti(state, block1, [][32]byte{{2}, alice})
+	check(err)
+	if isSomes[0] || !isSomes[1] || accounts[1].Flags != 3 {
+		t.Fatalf("accounts at block 1 are %+v, %v", accounts, isSomes)
+	}
+
+	sets, err := kinds.QueryCounterRange(state, block1, block2)
+	check(err)
+	if len(sets) != 2 || sets[0].Changes[0].Value != 5 || sets[1].Block != block2 || sets[1].Changes[0].Value != 6 {
+		t.Fatalf("counter changes are %+v", sets)
+	}
+
+	// Uncommitted changes are a pending block with the zero hash, which reads and queries of the
+	// latest block both see
+	check(chaintest.SetKindsCounter(state, 8))
+	counter, err = kinds.GetCounterLatest(state)
+	check(err)
+	if counter != 8 {
+		t.Fatalf("pending counter is %v", counter)
+	}
+	sets, err = kinds.QueryCounterRangeLatest(state, block1)
+	check(err)
+	if len(sets) != 3 || sets[1].Block != block2 || sets[2].Block != (types.Hash{}) || sets[2].Changes[0].Value != 8 {
+		t.Fatalf("counter changes up to the latest block are %+v", sets)
+	}
+	counter, err = kinds.GetCounter(state, block2)
+	check(err)
+	if counter != 6 {
+		t.Fatalf("counter at block 2 is %v after a pending change", counter)
+	}
+
+	// Committing the pending block makes it the last block
+	block3 := types.Hash{3}
+	state.Commit(block3)
+	sets, err = kinds.QueryCounterRangeLatest(state, block1)
+	check(err)
+	if len(sets) != 3 || sets[2].Block != block3 || sets[2].Changes[0].Value != 8 {
+		t.Fatalf("counter changes after committing are %+v", sets)
+	}
+}
+
+func TestMockNode(t *testing.T) {
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	node, err := mocknode.New(&kindstypes.Meta.AsMetadataV14)
+	check(err)
+	srv := httptest.NewServer(node)
+	defer srv.Close()
+	defer node.Close()
+	api, err := gsrpc.NewSubstrateAPI(srv.URL)
+	check(err)
+
+	// Seeded with the generated setters, and read through a node connection
+	check(chaintest.SetKindsCounter(node, 7))
+	counter, err := kinds.GetCounterLatest(api.RPC.State)
+	check(err)
+	if counter != 7 {
+		t.Fatalf("counter is %v", counter)
+	}
+
+	// Submitted extrinsics decode into the generated types
+	call := system.MakeRemarkCall([]byte("hi"))
+	ext, err := codec.EncodeToHex(kindstypes.Extrinsic{Call: call})
+	check(err)
+	var hash types.Hash
+	check(api.Client.Call(&hash, "author_submitExtrinsic", ext))
+	submitted := node.Submitted()
+	if len(submitted) != 1 || submitted[0].Hash != hash {
+		t.Fatalf("submitted %+v", submitted)
+	}
+	var got kindstypes.Extrinsic
+	check(submitted[0].Decode(&got))
+	if !got.Call.IsSystem || string(got.Call.AsSystemField0.AsRemarkRemark0) != "hi" {
+		t.Fatalf("submitted call is %+v", got.Call)
+	}
+}
+
+// A subscriber delivering the change sets sent to it, instead of a node's
+type fakeSubscriber struct {
+	keys         []types.StorageKey
+	sets         chan types.StorageChangeSet
+	errs         chan error
+	unsubscribed bool
+}
+
+func newFakeSubscriber() *fakeSubscriber {
+	return &fakeSubscriber{sets: make(chan types.StorageChangeSet), errs: make(chan error, 1)}
+}
+
+func (s *fakeSubscriber) SubscribeStorageRaw(keys []types.StorageKey) (kindstypes.StorageSubscription, error) {
+	s.keys = keys
+	return s, nil
+}
+
+func (s *fakeSubscriber) Chan() <-chan types.StorageChangeSet { return s.sets }
+func (s *fakeSubscriber) Err() <-chan error                   { return s.errs }
+func (s *fakeSubscriber) Unsubscribe()                        { s.unsubscribed = true }
+
+func TestFakeSubscriber(t *testing.T) {
+	sub := newFakeSubscriber()
+	sets, _, unsubscribe, err := kinds.SubscribeCounter(sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := kinds.MakeCounterStorageKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sub.keys) != 1 || sub.keys[0].Hex() != key.Hex() {
+		t.Fatalf("subscribed to %v", sub.keys)
+	}
+
+	sub.sets <- types.StorageChangeSet{Block: types.Hash{1}, Changes: []types.KeyValueOption{
+		{StorageKey: key, HasStorageData: true, StorageData: []byte{9, 0, 0, 0}},
+	}}
+	set := <-sets
+	if set.Block != (types.Hash{1}) || len(set.Changes) != 1 || set.Changes[0].Value != 9 {
+		t.Fatalf("change set is %+v", set)
+	}
+	unsubscribe()
+	if !sub.unsubscribed {
+		t.Fatal("unsubscribing didn't end the subscription")
+	}
+}
+
+func TestMultiGetters(t *testing.T) {
+	state := chaintest.NewState()
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	check(chaintest.SetKindsDefaulted(state, 1, 10))
+	check(chaintest.SetKindsTwox128(state, 1, 20))
+	block := types.Hash{1}
+	state.Commit(block)
+
+	// Duplicate keys each get the value, and missing keys read as the default
+	values, err := kinds.GetDefaultedMulti(state, block, []uint32{1, 2, 1})
+	check(err)
+	if len(values) != 3 || values[0] != 10 || values[1] != 7 || values[2] != 10 {
+		t.Fatalf("values are %v", values)
+	}
+
+	// Without a default, missing keys aren't some
+	optional, isSome, err := kinds.GetTwox128MultiLatest(state, []uint32{2, 1, 1})
+	check(err)
+	if len(optional) != 3 || isSome[0] || !isSome[1] || !isSome[2] || optional[1] != 20 || optional[2] != 20 {
+		t.Fatalf("values are %v, %v", optional, isSome)
+	}
+
+	values, err = kinds.GetDefaultedMulti(state, block, nil)
+	check(err)
+	if len(values) != 0 {
+		t.Fatalf("values of no keys are %v", values)
+	}
+}
+
+func TestSubscriptions(t *testing.T) {
+	alice, bob := [32]byte{1}, [32]byte{2}
+	aliceKey, err := kinds.MakeBlake2128ConcatStorageKey(alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bobKey, err := kinds.MakeBlake2128ConcatStorageKey(bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	account, err := codec.Encode(kindstypes.AccountData{Free: types.NewU128(*big.NewInt(5)), Flags: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Changes are typed and keyed by the subscribed keys, and deleted values aren't some
+	sub := newFakeSubscriber()
+	sets, errs, _, err := kinds.SubscribeBlake2128Concat(sub, alice, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sub.keys) != 2 || sub.keys[0].Hex() != aliceKey.Hex() || sub.keys[1].Hex() != bobKey.Hex() {
+		t.Fatalf("subscribed to %v", sub.keys)
+	}
+	sub.sets <- types.StorageChangeSet{Block: types.Hash{1}, Changes: []types.KeyValueOption{
+		{StorageKey: bobKey, HasStorageData: false},
+		{StorageKey: aliceKey, HasStorageData: true, StorageData: account},
+	}}
+	set := <-sets
+	if len(set.Changes) != 2 || set.Changes[0].Key != bob || set.Changes[0].IsSome ||
+		set.Changes[1].Key != alice || !set.Changes[1].IsSome || set.Changes[1].Value.Free.Int64() != 5 {
+		t.Fatalf("change set is %+v", set)
+	}
+
+	// A value which doesn't decode ends the subscription with an error
+	sub.sets <- types.StorageChangeSet{Block: types.Hash{2}, Changes: []types.KeyValueOption{
+		{StorageKey: aliceKey, HasStorageData: true, StorageData: []byte{1}},
+	}}
+	if err := <-errs; err == nil {
+		t.Fatal("decoded a truncated value")
+	}
+	if _, ok := <-sets; ok {
+		t.Fatal("the subscription continued after an error")
+	}
+	if !sub.unsubscribed {
+		t.Fatal("the node's subscription wasn't ended")
+	}
+
+	// So does an error of the node's subscription
+	sub = newFakeSubscriber()
+	_, errs, _, err = kinds.SubscribeBlake2128Concat(sub, alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub.errs <- fmt.Errorf("connection lost")
+	if err := <-errs; err == nil || err.Error() != "connection lost" {
+		t.Fatalf("error is %v", err)
+	}
+}
+
+func TestDecodeExtrinsic(t *testing.T) {
+	call := system.MakeRemarkCall([]byte("hi"))
+	enc, err := codec.Encode(kindstypes.Extrinsic{Call: call})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ext, err := kindstypes.DecodeExtrinsic(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ext.IsSigned || string(ext.Call.AsSystemField0.AsRemarkRemark0) != "hi" {
+		t.Fatalf("extrinsic is %+v", ext)
+	}
+
+	// Bytes after the extrinsic
+	if _, err := kindstypes.DecodeExtrinsic(append(enc, 0)); err == nil {
+		t.Fatal("decoded an extrinsic followed by a byte")
+	}
+	// Bytes after the call, within the extrinsic's length
+	callData, err := call.EncodeCallData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	padded, err := codec.Encode(append(append([]byte{4}, callData...), 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kindstypes.DecodeExtrinsic(padded); err == nil {
+		t.Fatal("decoded an extrinsic with a byte after its call")
+	}
+}
+
+func TestCallParams(t *testing.T) {
+	encode := func(call kindstypes.RuntimeCall) []byte {
+		t.Helper()
+		enc, err := codec.Encode(call)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return enc
+	}
+
+	// Pointer fields are required
+	if err := (kinds.DispatchParams{}).Validate(); err == nil {
+		t.Fatal("validated params without a call")
+	}
+	if _, err := (kinds.DispatchParams{}).Build(); err == nil {
+		t.Fatal("built params without a call")
+	}
+	remark := system.MakeRemarkCall([]byte("hi"))
+	built, err := kinds.DispatchParams{Call: &remark}.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(encode(built), encode(kinds.MakeDispatchCall(remark))) {
+		t.Fatalf("built %+v", built)
+	}
+
+	// Built calls equal the calls made from the same arguments
+	params := kinds.AllKindsParams{
+		Primitives: kindstypes.Primitives{AStr: "a", AU128: types.NewU128(*big.NewInt(1)), AU256: types.NewU256(*big.NewInt(2)),
+			AI128: types.NewI128(*big.NewInt(-3)), AI256: types.NewI256(*big.NewInt(4))},
+		Status:   kindstypes.Status{IsFrozen: true, AsFrozenUntil0: 9, AsFrozenReason1: []byte("why")},
+		Maybe:    kindstypes.OptionTUint32{IsSome: true, AsSomeField0: 5},
+		Accounts: []kindstypes.AccountData{{Free: types.NewU128(*big.NewInt(6)), Reserved: types.NewU128(*big.NewInt(0)), Flags: 7}},
+		Fixed:    [4]uint32{1, 2, 3, 4},
+		Pair:     kindstypes.TupleOfUint32Uint64{Elem0: 8, Elem1: 9},
+		Triple:   kindstypes.Tuple47{Elem0: 1, Elem1: 2, Elem2: 3},
+		Single:   10,
+		Small:    types.NewUCompactFromUInt(11),
+		Big:      types.NewUCompactFromUInt(12),
+		Bits:     []byte{0xff},
+		Tree:     kindstypes.Tree{IsNode: true, AsNodeField0: []kindstypes.Tree{{IsLeaf: true, AsLeafField0: 13}}},
+	}
+	if err := params.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	built, err = params.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	made := kinds.MakeAllKindsCall(params.Primitives, params.Status, params.Maybe, params.Accounts, params.Fixed,
+		params.Pair.Elem0, params.Pair.Elem1, params.Triple.Elem0, params.Triple.Elem1, params.Triple.Elem2,
+		params.Single, params.Small, params.Big, params.Bits, params.Tree)
+	if !bytes.Equal(encode(built), encode(made)) {
+		t.Fatalf("built %+v, made %+v", built, made)
+	}
+}
+
+func TestCallIntrospection(t *testing.T) {
+	remark := system.MakeRemarkCall([]byte("hi"))
+	if remark.PalletName() != "System" || remark.CallName() != "remark" {
+		t.Fatalf("remark is %v %v", remark.PalletName(), remark.CallName())
+	}
+	// System is the pallet with index 0, and remark its call with index 0
+	if pallet, ok := remark.PalletIndex(); pallet != 0 || !ok {
+		t.Fatalf("pallet index of remark is %v, %v", pallet, ok)
+	}
+	if call, ok := remark.CallIndex(); call != 0 || !ok {
+		t.Fatalf("call index of remark is %v, %v", call, ok)
+	}
+	if args := remark.Args(); len(args) != 1 || string(args["remark"].([]byte)) != "hi" {
+		t.Fatalf("args of remark are %v", args)
+	}
+
+	dispatch := kinds.MakeDispatchCall(remark)
+	if pallet, ok := dispatch.PalletIndex(); pallet != 1 || !ok {
+		t.Fatalf("pallet index of dispatch is %v, %v", pallet, ok)
+	}
+	if call, ok := dispatch.CallIndex(); call != 1 || !ok {
+		t.Fatalf("call index of dispatch is %v, %v", call, ok)
+	}
+	if inner, ok := dispatch.Args()["call"].(*kindstypes.RuntimeCall); !ok || inner.CallName() != "remark" {
+		t.Fatalf("args of dispatch are %v", dispatch.Args())
+	}
+	meta, ok := dispatch.Meta()
+	if !ok || meta.Pallet != "Kinds" || meta.Name != "dispatch" || len(meta.Args) != 1 ||
+		meta.Args[0].Name != "call" || meta.Args[0].TypeName != "Box<<T as Config>::RuntimeCall>" {
+		t.Fatalf("metadata of dispatch is %+v, %v", meta, ok)
+	}
+	byIndex, ok := kindstypes.LookupCall(types.CallIndex{SectionIndex: 1, MethodIndex: 1})
+	if !ok || byIndex.Name != "dispatch" {
+		t.Fatalf("call 1, 1 is %+v, %v", byIndex, ok)
+	}
+	if _, ok := kindstypes.LookupCall(types.CallIndex{SectionIndex: 1, MethodIndex: 9}); ok {
+		t.Fatal("found a call which doesn't exist")
+	}
+
+	// An empty call has no pallet or call, rather than the ones with index 0
+	var empty kindstypes.RuntimeCall
+	if _, ok := empty.PalletIndex(); ok {
+		t.Fatal("an empty call has a pallet")
+	}
+	if _, ok := empty.CallIndex(); ok {
+		t.Fatal("an empty call has a call")
+	}
+	if _, ok := empty.Meta(); ok {
+		t.Fatal("an empty call has metadata")
+	}
+	if empty.PalletName() != "" || empty.CallName() != "" || empty.Args() != nil {
+		t.Fatalf("an empty call is %v %v %v", empty.PalletName(), empty.CallName(), empty.Args())
+	}
+}
+
+// Copy the metadata the code was generated from, to change it like a runtime upgrade would
+func copyMeta(t *testing.T) *types.Metadata {
+	t.Helper()
+	enc, err := codec.Encode(kindstypes.Meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var meta types.Metadata
+	if err := codec.Decode(enc, &meta); err != nil {
+		t.Fatal(err)
+	}
+	return &meta
+}
+
+func TestCheckCompatibility(t *testing.T) {
+	// The generated hasher hashes the metadata like the generator did
+	report, err := kindstypes.CheckCompatibility(copyMeta(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Compatible() || len(report.Incompatible()) != 0 {
+		t.Fatalf("incompatible with its own metadata: %v", report.Incompatible())
+	}
+
+	// Renaming the field of System.remark breaks it and the calls which wrap any call
+	live := copyMeta(t)
+	for i, ty := range live.AsMetadataV14.Lookup.Types {
+		if len(ty.Type.Path) > 0 && ty.Type.Path[0] == "frame_system" && ty.Type.Path[len(ty.Type.Path)-1] == "Call" {
+			live.AsMetadataV14.Lookup.Types[i].Type.Def.Variant.Variants[0].Fields[0].Name = "note"
+		}
+	}
+	report, err = kindstypes.CheckCompatibility(live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fmt.Sprint(report.Incompatible()); got != "[Kinds.call.dispatch System.call.remark]" {
+		t.Fatalf("incompatible items are %v", got)
+	}
+	if !report["Kinds"].Calls["all_kinds"] || !report["System"].Storage["BlockHash"] {
+		t.Fatalf("report is %+v", report)
+	}
+
+	// Missing pallets are reported
+	live = copyMeta(t)
+	live.AsMetadataV14.Pallets = live.AsMetadataV14.Pallets[1:]
+	report, err = kindstypes.CheckCompatibility(live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report["System"].Present || report.Compatible() || report.Incompatible()[0] != "System" {
+		t.Fatalf("report is %+v", report)
+	}
+}
+
+// Check that the JSON of decoded values matches the schema generated for their types
+func TestJSONSchema(t *testing.T) {
+	data, err := os.ReadFile("testdata/schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Defs map[string]interface{} `json:"$defs"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	allKinds := kinds.MakeAllKindsCall(
+		kindstypes.Primitives{AStr: "a", AU128: types.NewU128(*new(big.Int).Lsh(big.NewInt(1), 100)), AU256: types.NewU256(*big.NewInt(2)),
+			AI128: types.NewI128(*big.NewInt(-3)), AI256: types.NewI256(*big.NewInt(4)), AU64: 1 << 63, AI8: -1},
+		kindstypes.Status{IsFrozen: true, AsFrozenUntil0: 9, AsFrozenReason1: []byte("why")},
+		kindstypes.OptionTUint32{IsSome: true, AsSomeField0: 5},
+		[]kindstypes.AccountData{{Free: types.NewU128(*big.NewInt(6)), Reserved: types.NewU128(*big.NewInt(0)), Flags: 7}},
+		[4]uint32{1, 2, 3, 4}, 8, 9, 1, 2, 3, 10,
+		types.NewUCompactFromUInt(11), types.NewUCompact(new(big.Int).Lsh(big.NewInt(1), 100)),
+		[]byte{0xff},
+		kindstypes.Tree{IsNode: true, AsNodeField0: []kindstypes.Tree{{IsLeaf: true, AsLeafField0: 13}}},
+	)
+	for _, c := range []struct {
+		def   string
+		value interface{}
+		into  interface{}
+	}{
+		// Variants with several fields and one field, byte slices, big integers and compacts
+		{"RuntimeCall", allKinds, new(kindstypes.RuntimeCall)},
+		// A variant without fields
+		{"Status", kindstypes.Status{IsActive: true}, new(kindstypes.Status)},
+		// A byte array
+		{"MultiAddress", kindstypes.MultiAddress{IsId: true, AsIdField0: [32]byte{1, 2}}, new(kindstypes.MultiAddress)},
+	} {
+		enc, err := codec.Encode(c.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := codec.Decode(enc, c.into); err != nil {
+			t.Fatalf("%v: %v", c.def, err)
+		}
+		js, err := json.Marshal(c.into)
+		if err != nil {
+			t.Fatalf("%v: %v", c.def, err)
+		}
+		dec := json.NewDecoder(bytes.NewReader(js))
+		dec.UseNumber()
+		var value interface{}
+		if err := dec.Decode(&value); err != nil {
+			t.Fatal(err)
+		}
+		if err := validate(doc.Defs, doc.Defs[c.def], value); err != nil {
+			t.Errorf("%v: %s doesn't match the schema: %v", c.def, js, err)
+		}
+	}
+
+	// Compacts marshal without their value, like the schema describes
+	js, err := json.Marshal(types.NewUCompactFromUInt(11))
+	if err != nil || string(js) != `{}` {
+		t.Fatalf("compact marshals to %s, %v", js, err)
+	}
+}
+
+// Validate a JSON value, decoded with numbers as json.Number, against a schema using the keywords
+// of the generated schemas
+func validate(defs map[string]interface{}, schema interface{}, value interface{}) error {
+	s, ok := schema.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("bad schema %v", schema)
+	}
+	if ref, ok := s["$ref"].(string); ok {
+		def, ok := defs[strings.TrimPrefix(ref, "#/$defs/")]
+		if !ok {
+			return fmt.Errorf("unknown reference %v", ref)
+		}
+		return validate(defs, def, value)
+	}
+	if c, ok := s["const"]; ok && !reflect.DeepEqual(c, value) {
+		return fmt.Errorf("%v isn't %v", value, c)
+	}
+	if oneOf, ok := s["oneOf"].([]interface{}); ok {
+		matches := 0
+		for _, sub := range oneOf {
+			if validate(defs, sub, value) == nil {
+				matches++
+			}
+		}
+		if matches != 1 {
+			return fmt.Errorf("%v matches %v schemas of oneOf", value, matches)
+		}
+	}
+
+	switch s["type"] {
+	case nil:
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%v isn't a boolean", value)
+		}
+	case "integer":
+		n, ok := value.(json.Number)
+		i, isInt := new(big.Int).SetString(string(n), 10)
+		if !ok || !isInt {
+			return fmt.Errorf("%v isn't an integer", value)
+		}
+		for keyword, sign := range map[string]int{"minimum": -1, "maximum": 1} {
+			if limit, ok := s[keyword].(float64); ok {
+				l, _ := new(big.Float).SetFloat64(limit).Int(nil)
+				if i.Cmp(l) == sign {
+					return fmt.Errorf("%v is past the %v %v", i, keyword, l)
+				}
+			}
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%v isn't a string", value)
+		}
+		if s["contentEncoding"] == "base64" {
+			if _, err := base64.StdEncoding.DecodeString(str); err != nil {
+				return fmt.Errorf("%q isn't base64: %v", str, err)
+			}
+		}
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%v isn't an array", value)
+		}
+		if min, ok := s["minItems"].(float64); ok && len(items) < int(min) {
+			return fmt.Errorf("%v has fewer than %v items", value, min)
+		}
+		if max, ok := s["maxItems"].(float64); ok && len(items) > int(max) {
+			return fmt.Errorf("%v has more than %v items", value, max)
+		}
+		for i, item := range items {
+			if err := validate(defs, s["items"], item); err != nil {
+				return fmt.Errorf("item %v: %v", i, err)
+			}
+		}
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%v isn't an object", value)
+		}
+		if max, ok := s["maxProperties"].(float64); ok && len(obj) > int(max) {
+			return fmt.Errorf("%v has more than %v properties", value, max)
+		}
+		props, _ := s["properties"].(map[string]interface{})
+		required, _ := s["required"].([]interface{})
+		for _, name := range required {
+			if _, ok := obj[name.(string)]; !ok {
+				return fmt.Errorf("%v is missing %v", value, name)
+			}
+		}
+		for name, v := range obj {
+			prop, ok := props[name]
+			if !ok {
+				if s["additionalProperties"] == false {
+					return fmt.Errorf("%v has the unexpected property %v", value, name)
+				}
+				continue
+			}
+			if err := validate(defs, prop, v); err != nil {
+				return fmt.Errorf("%v: %v", name, err)
+			}
+		}
+	default:
+		return fmt.Errorf("unexpected type %v", s["type"])
+	}
+	return nil
+}
+
+// Check that integers convert from protobuf at the bounds of their type, and fail past them
+func TestProtoConv(t *testing.T) {
+	p := kindstypes.Primitives{AU8: 255, AU16: 65535, AI8: -128, AI16: -32768}
+	m, err := protoconv.PrimitivesToProto(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	back, err := protoconv.PrimitivesFromProto(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.AU8 != p.AU8 || back.AU16 != p.AU16 || back.AI8 != p.AI8 || back.AI16 != p.AI16 {
+		t.Fatalf("got %+v, expected %+v", back, p)
+	}
+
+	for _, m := range []*typespb.Primitives{{Au8: 256}, {Au16: 65536}, {Ai8: 128}, {Ai8: -129}, {Ai16: 32768}, {Ai16: -32769}} {
+		if _, err := protoconv.PrimitivesFromProto(m); err == nil || !strings.Contains(err.Error(), "overflows") {
+			t.Fatalf("expected an overflow error converting %v, got %v", m, err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Package usage tests the code generated for the minimal fixture with context support. TestBuildGenerated
+// copies it into the generated module, as usage/usage_test.go.
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"example.com/minimal/chaintest"
+	"example.com/minimal/system"
+)
+
+func TestContext(t *testing.T) {
+	state := chaintest.NewState()
+	if err := chaintest.SetSystemBlockHash(state, 1, [32]byte{1}); err != nil {
+		t.Fatal(err)
+	}
+
+	hash, isSome, err := system.GetBlockHashLatest(context.Background(), state, 1)
+	if err != nil || !isSome || hash != ([32]byte{1}) {
+		t.Fatalf("block hash is %v, %v, %v", hash, isSome, err)
+	}
+
+	// The context reaches the backend
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := system.GetBlockHashLatest(ctx, state, 1); !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled get returned %v", err)
+	}
+	if _, _, err := system.GetBlockHashMultiLatest(ctx, state, []uint32{1}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("cancelled multi get returned %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+// Package usage tests the code generated for the fixtures as versions of one runtime like a user
+// would: minimal as spec version 100, kinds as 200 and wrappers as 300. TestBuildGenerated copies
+// it into the generated module, as usage/usage_test.go.
+package usage
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"example.com/versions/dispatch"
+	"example.com/versions/v100/system"
+	v100types "example.com/versions/v100/types"
+	"example.com/versions/v200/kinds"
+	v200types "example.com/versions/v200/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+func TestVersionFor(t *testing.T) {
+	for spec, want := range map[uint32]uint32{100: 100, 150: 100, 200: 200, 299: 200, 300: 300, 1000: 300} {
+		if got, err := dispatch.VersionFor(spec); err != nil || got != want {
+			t.Errorf("version for %v is %v, %v, want %v", spec, got, err, want)
+		}
+	}
+	if _, err := dispatch.VersionFor(99); err == nil {
+		t.Error("found a version for a spec version before the first one")
+	}
+}
+
+func TestDecodeCall(t *testing.T) {
+	remark, err := codec.Encode(system.MakeRemarkCall([]byte("hi")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := dispatch.DecodeCall(150, remark)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if call, ok := decoded.(v100types.RuntimeCall); !ok || call.CallName() != "remark" {
+		t.Fatalf("remark decodes to %#v", decoded)
+	}
+
+	// The Kinds pallet was added in 200
+	dispatchCall, err := codec.Encode(kinds.MakeDispatchCall(kinds.MakeUnusedCall(struct{}{})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dispatch.DecodeCall(150, dispatchCall); err == nil {
+		t.Fatal("decoded a call of a pallet which isn't in spec version 100")
+	}
+	decoded, err = dispatch.DecodeCall(250, dispatchCall)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if call, ok := decoded.(v200types.RuntimeCall); !ok || call.PalletName() != "Kinds" || call.CallName() != "dispatch" {
+		t.Fatalf("dispatch decodes to %#v", decoded)
+	}
+}
+
+func TestDecodeStorage(t *testing.T) {
+	data, err := codec.Encode(uint32(7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := dispatch.DecodeStorage(200, "Kinds", "Counter", data)
+	if err != nil || value != uint32(7) {
+		t.Fatalf("Kinds.Counter decodes to %#v, %v", value, err)
+	}
+	if _, err := dispatch.DecodeStorage(100, "Kinds", "Counter", data); err == nil {
+		t.Fatal("decoded storage of a pallet which isn't in spec version 100")
+	}
+	if _, err := dispatch.DecodeStorage(50, "System", "BlockHash", data); err == nil {
+		t.Fatal("decoded storage of a spec version before the first one")
+	}
+}
+
+func TestDecodeEvents(t *testing.T) {
+	events := []v200types.EventRecord{{
+		Event: v200types.RuntimeEvent{IsKinds: true, AsKindsField0: &v200types.PalletKindsPalletEvent{
+			IsHappened:        true,
+			AsHappenedWho0:    [32]byte{1},
+			AsHappenedAmount1: types.NewU128(*big.NewInt(5)),
+		}},
+	}}
+	data, err := codec.Encode(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := dispatch.DecodeEvents(200, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, events) {
+		t.Fatalf("events decode to %#v", decoded)
+	}
+
+	// Spec version 100 has no Kinds pallet, and so no Kinds events
+	if _, err := dispatch.DecodeEvents(100, data); err == nil {
+		t.Fatal("decoded events of a pallet which isn't in spec version 100")
+	}
+}
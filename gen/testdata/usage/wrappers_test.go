@@ -0,0 +1,127 @@
+// Package usage tests the code generated for the wrappers fixture like a user would. TestBuildGenerated
+// copies it into the generated module, as usage/usage_test.go.
+package usage
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"example.com/wrappers/multisig"
+	"example.com/wrappers/proxy"
+	"example.com/wrappers/sudo"
+	"example.com/wrappers/system"
+	wrapperstypes "example.com/wrappers/types"
+	"example.com/wrappers/utility"
+)
+
+// The call data of system.remark("hi"): pallet 0, call 0, and the bytes with their compact length
+const remark = "00" + "00" + "08" + "6869"
+
+// The call data of a MultiAddress::Id of 32 copies of a byte
+func id(b string) string {
+	return "00" + strings.Repeat(b, 32)
+}
+
+func account(b byte) [32]byte {
+	var a [32]byte
+	for i := range a {
+		a[i] = b
+	}
+	return a
+}
+
+// Check that each wrapper encodes to the call data a node expects, and that the call data decodes
+// back to the wrapper
+func TestWrappers(t *testing.T) {
+	call := system.MakeRemarkCall([]byte("hi"))
+	timepoint := wrapperstypes.OptionTTimepoint{IsSome: true, AsSomeField0: wrapperstypes.Timepoint{Height: 7, Index: 1}}
+	asMulti, err := multisig.AsMulti(2, [][32]byte{account(4)}, timepoint, call, false, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, c := range map[string]struct {
+		call wrapperstypes.RuntimeCall
+		want string
+	}{
+		"remark": {call, remark},
+		// Utility is pallet 1, and the calls are prefixed by their compact length
+		"batch":         {utility.Batch(call, call), "0100" + "08" + remark + remark},
+		"as_derivative": {utility.AsDerivative(5, call), "0101" + "0500" + remark},
+		"batch_all":     {utility.BatchAll(call), "0102" + "04" + remark},
+		"force_batch":   {utility.ForceBatch(), "0103" + "00"},
+		// Sudo is pallet 2
+		"sudo":                  {sudo.AsSudo(call), "0200" + remark},
+		"sudo_unchecked_weight": {sudo.AsSudoUncheckedWeight(call, 1000), "0201" + remark + "e803000000000000"},
+		"sudo_as": {
+			sudo.SudoAs(wrapperstypes.MultiAddress{IsId: true, AsIdField0: account(1)}, call),
+			"0202" + id("01") + remark,
+		},
+		// Proxy is pallet 3
+		"proxy": {
+			proxy.AsProxy(
+				wrapperstypes.MultiAddress{IsId: true, AsIdField0: account(2)},
+				wrapperstypes.OptionTProxyType{IsSome: true, AsSomeField0: &wrapperstypes.ProxyType{IsAny: true}},
+				call,
+			),
+			"0300" + id("02") + "0100" + remark,
+		},
+		"proxy_announced": {
+			proxy.AsProxyAnnounced(
+				wrapperstypes.MultiAddress{IsId: true, AsIdField0: account(3)},
+				wrapperstypes.MultiAddress{IsId: true, AsIdField0: account(2)},
+				wrapperstypes.OptionTProxyType{IsNone: true},
+				call,
+			),
+			"0301" + id("03") + id("02") + "00" + remark,
+		},
+		// Multisig is pallet 4. as_multi takes the call as a WrapperKeepOpaque, prefixed by the
+		// compact length of its call data
+		"as_multi_threshold_1": {
+			multisig.AsMultiThreshold1([][32]byte{account(4)}, call),
+			"0400" + "04" + strings.Repeat("04", 32) + remark,
+		},
+		"as_multi": {
+			asMulti,
+			"0401" + "0200" + "04" + strings.Repeat("04", 32) + "01" + "07000000" + "01000000" + "14" + remark + "00" + "e803000000000000",
+		},
+	} {
+		want, err := hex.DecodeString(c.want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := c.call.EncodeCallData()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, want) {
+			t.Errorf("%v: got call data %x, want %x", name, data, want)
+			continue
+		}
+		decoded, err := wrapperstypes.DecodeCallData(want)
+		if err != nil {
+			t.Fatalf("%v: %v", name, err)
+		}
+		again, err := decoded.EncodeCallData()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(again, want) {
+			t.Errorf("%v: call data decodes to a call with call data %x", name, again)
+		}
+	}
+}
+
+// Check that the call hash is the blake2-256 hash of the call data, which multisig approvals use
+func TestCallHash(t *testing.T) {
+	hash, err := multisig.CallHash(system.MakeRemarkCall([]byte("hi")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// blake2b-256 of 0000086869
+	if want := "89efc84be36b223a83707f2e577f86a99a79cac6b6d5c4c09b231384605ef27b"; hex.EncodeToString(hash[:]) != want {
+		t.Errorf("got call hash %x, want %v", hash, want)
+	}
+}
@@ -3,6 +3,7 @@ module github.com/aphoh/go-substrate-gen
 go 1.18
 
 require (
+	github.com/centrifuge/go-substrate-rpc-client/v4 v4.0.7
 	github.com/dave/jennifer v1.5.0
 	github.com/gobeam/stringy v0.0.5
 	github.com/stretchr/testify v1.7.1
@@ -10,7 +11,6 @@ require (
 
 require (
 	github.com/ChainSafe/go-schnorrkel v1.0.0 // indirect
-	github.com/centrifuge/go-substrate-rpc-client/v4 v4.0.7 // indirect
 	github.com/cosmos/go-bip39 v1.0.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/deckarep/golang-set v1.8.0 // indirect
@@ -0,0 +1,27 @@
+// Package testmeta loads go-substrate-rpc-client's example metadata, of a full node runtime, for the
+// tests of the generator's packages.
+package testmeta
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/require"
+)
+
+// Decode the example metadata. Its runtime predates the renaming of `Call` and `Event` to
+// `RuntimeCall` and `RuntimeEvent`, so they're renamed like a current runtime names them. Each call
+// decodes a new copy, which the test may change.
+func Example(t testing.TB) *types.MetadataV14 {
+	t.Helper()
+	var meta types.Metadata
+	require.NoError(t, codec.DecodeFromHex(types.MetadataV14Data, &meta))
+	for i, ty := range meta.AsMetadataV14.Lookup.Types {
+		p := ty.Type.Path
+		if len(p) == 2 && p[0] == "node_runtime" && (p[1] == "Call" || p[1] == "Event") {
+			meta.AsMetadataV14.Lookup.Types[i].Type.Path[1] = "Runtime" + p[1]
+		}
+	}
+	return &meta.AsMetadataV14
+}
@@ -3,14 +3,22 @@ package main
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 
+	"github.com/aphoh/go-substrate-gen/callenc"
+	"github.com/aphoh/go-substrate-gen/gen"
 	"github.com/aphoh/go-substrate-gen/metadata"
-	"github.com/aphoh/go-substrate-gen/palletgen"
-	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/metadiff"
+	"github.com/aphoh/go-substrate-gen/mocknode"
+	"github.com/aphoh/go-substrate-gen/textdiff"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/hash"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 )
 
 const VERSION = "0.8.0"
@@ -23,15 +31,64 @@ func main() {
 }
 
 func run() error {
-	args := os.Args[1:]
-	// check for --version / -v
-	for _, arg := range args {
-		if arg == "-v" || arg == "--version" {
+	// Split the flags from the positional arguments
+	args := []string{}
+	// Warnings go to stderr, so they don't mix with output like the schema
+	opts := gen.Options{Warnings: os.Stderr}
+	check := false
+	for i := 1; i < len(os.Args); i++ {
+		arg := os.Args[i]
+		switch arg {
+		case "-v", "--version":
 			fmt.Printf("go-substrate-gen version %s\n", VERSION)
 			return nil
+		case "--ctx":
+			// Take a context.Context in every function that talks to a node
+			opts.WithContext = true
+		case "--check":
+			// Compare the generated code with the files on disk instead of writing it
+			check = true
+		case "--tests":
+			// Generate round-trip tests and fuzz targets of the types
+			opts.WithTests = true
+		case "--chaintest":
+			// Generate a fake storage for tests, and the functions seeding it
+			opts.WithChainTest = true
+		case "--proto":
+			// Generate protobuf messages of the types, and converters to and from them
+			opts.WithProto = true
+		case "--docs":
+			// Write a Markdown reference of the generated API into a directory
+			if i+1 == len(os.Args) {
+				return fmt.Errorf("expected a directory after --docs")
+			}
+			i++
+			dir, err := docsDir(os.Args[i])
+			if err != nil {
+				return err
+			}
+			opts.DocsDir = dir
+		default:
+			args = append(args, arg)
 		}
 	}
 
+	if len(args) > 0 && args[0] == "encode-call" {
+		return encodeCall(args[1:])
+	}
+	if len(args) > 0 && args[0] == "diff" {
+		return diff(args[1:])
+	}
+	if len(args) > 0 && args[0] == "mocknode" {
+		return mockNode(args[1:])
+	}
+	if len(args) > 0 && args[0] == "schema" {
+		return schema(args[1:], opts)
+	}
+	if len(args) > 0 && args[0] == "versions" {
+		return generateVersions(args[1:], opts, check)
+	}
+
 	if len(args) < 2 {
 		return fmt.Errorf("expected two arguments (json path, package name)")
 	}
@@ -45,61 +102,294 @@ func run() error {
 		return fmt.Errorf("error reading json: %v", err.Error())
 	}
 	// go-substrate-rpc-client parsed metadata
-	meta, encResp, err := metadata.ParseMetadata(raw)
+	meta, _, err := metadata.ParseMetadata(raw)
 	if err != nil {
 		return fmt.Errorf("error parsing metadata: %v", err.Error())
 	}
-	// structure:
-	// ./types/types.go
-	// ./pallets/$PALLET/storage.go
-	// ./pallets/$PALLET/calls.go
+	opts.PkgPath = extPkgPath
+	files, err := gen.Generate(meta, opts)
+	if err != nil {
+		return err
+	}
+	return output(files, check)
+}
 
-	typesPath := path.Join(extPkgPath, "/types")
-	tg := typegen.NewTypeGenerator(meta, encResp, typesPath)
+// Get the docs directory relative to the working directory, which the generated files are written
+// into, in the slash-separated form of gen.Options
+func docsDir(dir string) (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(wd, abs)
+	if err != nil {
+		return "", fmt.Errorf("bad docs directory %v: %v", dir, err)
+	}
+	return filepath.ToSlash(rel), nil
+}
 
-	for _, pallet := range meta.Pallets {
-		lowerName := strings.ToLower(string(pallet.Name))
-		palletPath := path.Join(extPkgPath, "/"+lowerName)
-		pg := palletgen.NewPalletGenerator(&pallet, &tg)
+// Write the generated files into the working directory. With `check`, nothing is written: the files
+// are compared with those on disk instead, printing a diff of each stale file, and an error is
+// returned if any are stale. The pallet files of pallets which are no longer generated are stale too.
+func output(files map[string][]byte, check bool) error {
+	if !check {
+		return gen.Write(files, gen.DirSink("."))
+	}
 
-		fp := filepath.Join(".", lowerName)
-		err = os.MkdirAll(fp, os.ModePerm)
+	stale := 0
+	for _, p := range gen.SortedPaths(files) {
+		oldName := p
+		old, err := ioutil.ReadFile(filepath.FromSlash(p))
+		if os.IsNotExist(err) {
+			oldName = "/dev/null"
+		} else if err != nil {
+			return fmt.Errorf("error reading %v: %v", p, err)
+		}
+		if d := textdiff.Unified(oldName, p, string(old), string(files[p])); d != "" {
+			fmt.Print(d)
+			stale += 1
+		}
+	}
+	removed, err := leftoverFiles(files)
+	if err != nil {
+		return err
+	}
+	for _, p := range removed {
+		old, err := ioutil.ReadFile(filepath.FromSlash(p))
 		if err != nil {
-			return fmt.Errorf("error creating pallet %v path: %v", pallet.Name, err)
+			return fmt.Errorf("error reading %v: %v", p, err)
 		}
+		fmt.Print(textdiff.Unified(p, "/dev/null", string(old), ""))
+		stale += 1
+	}
+	if stale > 0 {
+		return fmt.Errorf("%v generated files are out of date", stale)
+	}
+	return nil
+}
 
-		storage, isSome, err := pg.GenerateStorage(palletPath)
+// The names of the files generated into a pallet's directory
+var palletFiles = []string{"storage.go", "calls.go"}
+
+// Find the pallet files on disk which aren't generated anymore, e.g. of a removed pallet: the
+// storage.go and calls.go files in the directories next to the generated types directories, which
+// aren't in `files`. Returns their slash-separated paths, sorted.
+func leftoverFiles(files map[string][]byte) ([]string, error) {
+	res := []string{}
+	for _, p := range gen.SortedPaths(files) {
+		if path.Base(p) != "types.go" || path.Base(path.Dir(p)) != "types" {
+			continue
+		}
+		// The generated package, or a runtime version's directory in it
+		root := path.Dir(path.Dir(p))
+		entries, err := os.ReadDir(filepath.FromSlash(root))
 		if err != nil {
-			return fmt.Errorf("error generating storage for pallet %v: %v", pallet.Name, err)
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
 		}
-		if isSome {
-			err = ioutil.WriteFile(filepath.Join(fp, "storage.go"), []byte(storage), 0644)
-			if err != nil {
-				return fmt.Errorf("error writing storage.go for pallet %v: %v", pallet.Name, err)
+		for _, e := range entries {
+			if !e.IsDir() {
+				continue
+			}
+			for _, name := range palletFiles {
+				file := path.Join(root, e.Name(), name)
+				if _, ok := files[file]; ok {
+					continue
+				}
+				if _, err := os.Stat(filepath.FromSlash(file)); err == nil {
+					res = append(res, file)
+				}
 			}
 		}
+	}
+	return res, nil
+}
+
+// Generate the code for several runtime versions, given as <spec version>=<json path> arguments.
+// The package path of `opts` is taken from the arguments.
+func generateVersions(args []string, opts gen.Options, check bool) error {
+	if len(args) < 2 {
+		return fmt.Errorf("expected arguments: versions <package name> <spec version>=<json path>...")
+	}
+	opts.PkgPath = args[0]
 
-		calls, isSome, err := pg.GenerateCalls(palletPath)
+	versions := []gen.Version{}
+	for _, arg := range args[1:] {
+		spec, jsonPath, ok := strings.Cut(arg, "=")
+		if !ok {
+			return fmt.Errorf("expected <spec version>=<json path>, got %v", arg)
+		}
+		specVersion, err := strconv.ParseUint(spec, 10, 32)
 		if err != nil {
-			return fmt.Errorf("error generating calls for pallet %v: %v", pallet.Name, err)
+			return fmt.Errorf("bad spec version %v: %v", spec, err)
 		}
-		if isSome {
-			err = ioutil.WriteFile(filepath.Join(fp, "calls.go"), []byte(calls), 0644)
-			if err != nil {
-				return fmt.Errorf("error writing calls.go for pallet %v: %v", pallet.Name, err)
-			}
+		raw, err := ioutil.ReadFile(jsonPath)
+		if err != nil {
+			return fmt.Errorf("error reading json: %v", err.Error())
+		}
+		meta, _, err := metadata.ParseMetadata(raw)
+		if err != nil {
+			return fmt.Errorf("error parsing metadata of spec version %v: %v", specVersion, err.Error())
 		}
+		versions = append(versions, gen.Version{SpecVersion: uint32(specVersion), Meta: meta})
 	}
-	err = tg.GenerateCallHelpers()
+
+	files, err := gen.GenerateVersions(versions, opts)
 	if err != nil {
 		return err
 	}
+	return output(files, check)
+}
 
-	typesDir := filepath.Join(".", "types")
-	os.MkdirAll(typesDir, os.ModePerm)
-	typesFp := filepath.Join(typesDir, "types.go")
-	typesGenerated := tg.GetGenerated()
-	ioutil.WriteFile(typesFp, []byte(typesGenerated), 0644)
+// Print the changes to the generated API between two metadata, as text or as JSON with --json.
+// Returns an error if any change is breaking, so it can gate CI.
+func diff(args []string) error {
+	files := []string{}
+	asJson := false
+	for _, arg := range args {
+		if arg == "--json" {
+			asJson = true
+		} else {
+			files = append(files, arg)
+		}
+	}
+	if len(files) != 2 {
+		return fmt.Errorf("expected arguments: diff [--json] <old json path> <new json path>")
+	}
+
+	metas := []*types.MetadataV14{}
+	for _, f := range files {
+		raw, err := ioutil.ReadFile(f)
+		if err != nil {
+			return fmt.Errorf("error reading json: %v", err.Error())
+		}
+		meta, _, err := metadata.ParseMetadata(raw)
+		if err != nil {
+			return fmt.Errorf("error parsing metadata %v: %v", f, err.Error())
+		}
+		metas = append(metas, meta)
+	}
 
+	report, err := metadiff.Diff(metas[0], metas[1])
+	if err != nil {
+		return fmt.Errorf("error comparing metadata: %v", err)
+	}
+	if asJson {
+		out, err := report.JSON()
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	} else {
+		fmt.Print(report.String())
+	}
+
+	if breaking := len(report.Breaking()); breaking > 0 {
+		return fmt.Errorf("%v breaking changes", breaking)
+	}
+	return nil
+}
+
+// Print a JSON Schema of the JSON encoding of the generated types, or an OpenAPI document with
+// --openapi
+func schema(args []string, opts gen.Options) error {
+	files := []string{}
+	openAPI := false
+	for _, arg := range args {
+		if arg == "--openapi" {
+			openAPI = true
+		} else {
+			files = append(files, arg)
+		}
+	}
+	if len(files) != 2 {
+		return fmt.Errorf("expected arguments: schema [--openapi] <json path> <package name>")
+	}
+
+	raw, err := ioutil.ReadFile(files[0])
+	if err != nil {
+		return fmt.Errorf("error reading json: %v", err.Error())
+	}
+	meta, _, err := metadata.ParseMetadata(raw)
+	if err != nil {
+		return fmt.Errorf("error parsing metadata: %v", err.Error())
+	}
+	opts.PkgPath = files[1]
+	out, err := gen.Schema(meta, opts, openAPI)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
 	return nil
 }
+
+// Print the call data and call hash of a call, with its arguments given as JSON, e.g. to prepare a
+// governance proposal offline
+func encodeCall(args []string) error {
+	if len(args) < 3 {
+		return fmt.Errorf("expected arguments: encode-call <json path> <pallet> <call> [json call arguments]")
+	}
+	callArgs := []byte{}
+	if len(args) > 3 {
+		callArgs = []byte(args[3])
+	}
+
+	raw, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("error reading json: %v", err.Error())
+	}
+	meta, _, err := metadata.ParseMetadata(raw)
+	if err != nil {
+		return fmt.Errorf("error parsing metadata: %v", err.Error())
+	}
+
+	data, err := callenc.EncodeCall(meta, args[1], args[2], callArgs)
+	if err != nil {
+		return fmt.Errorf("error encoding call: %v", err)
+	}
+	h, err := hash.NewBlake2b256(nil)
+	if err != nil {
+		return err
+	}
+	h.Write(data)
+
+	fmt.Printf("call data: %v\n", codec.HexEncodeToString(data))
+	fmt.Printf("call hash: %v\n", codec.HexEncodeToString(h.Sum(nil)))
+	return nil
+}
+
+// Serve a mock node for a metadata file until interrupted, printing every submitted extrinsic
+func mockNode(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("expected arguments: mocknode <json path> [listen address]")
+	}
+	addr := "127.0.0.1:9944"
+	if len(args) > 1 {
+		addr = args[1]
+	}
+
+	raw, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("error reading json: %v", err.Error())
+	}
+	meta, _, err := metadata.ParseMetadata(raw)
+	if err != nil {
+		return fmt.Errorf("error parsing metadata: %v", err.Error())
+	}
+	node, err := mocknode.New(meta)
+	if err != nil {
+		return err
+	}
+	node.OnSubmit = func(sub mocknode.Submitted) {
+		fmt.Printf("submitted extrinsic %v: %v\n", sub.Hash.Hex(), codec.HexEncodeToString(sub.Data))
+	}
+
+	fmt.Printf("serving a mock node on http://%v and ws://%v\n", addr, addr)
+	return http.ListenAndServe(addr, node)
+}
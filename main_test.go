@@ -0,0 +1,64 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The test binary runs the command instead of the tests when GO_SUBSTRATE_GEN_MAIN is set, so tests
+// can check its output and exit code
+func TestMain(m *testing.M) {
+	if os.Getenv("GO_SUBSTRATE_GEN_MAIN") == "1" {
+		main()
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// Run the command in a directory, returning its output and exit code
+func runMain(t *testing.T, dir string, args ...string) (string, int) {
+	t.Helper()
+	cmd := exec.Command(os.Args[0], args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GO_SUBSTRATE_GEN_MAIN=1")
+	out, err := cmd.CombinedOutput()
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		return string(out), exitErr.ExitCode()
+	}
+	require.NoError(t, err)
+	return string(out), 0
+}
+
+func TestCheck(t *testing.T) {
+	fixture, err := filepath.Abs(filepath.Join("testdata", "fixtures", "minimal.json"))
+	require.NoError(t, err)
+	dir := t.TempDir()
+	_, code := runMain(t, dir, fixture, "example.com/minimal")
+	require.Equal(t, 0, code)
+	out, code := runMain(t, dir, "--check", fixture, "example.com/minimal")
+	require.Equal(t, 0, code, out)
+	require.Empty(t, out)
+
+	// Changed files are stale, including a missing newline at the end
+	storage := filepath.Join(dir, "system", "storage.go")
+	content, err := os.ReadFile(storage)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(storage, content[:len(content)-1], 0644))
+	out, code = runMain(t, dir, "--check", fixture, "example.com/minimal")
+	require.Equal(t, 1, code, out)
+	require.Contains(t, out, "--- system/storage.go\n+++ system/storage.go\n")
+	require.Contains(t, out, "\\ No newline at end of file\n")
+	require.Contains(t, out, "1 generated files are out of date")
+	require.NoError(t, os.WriteFile(storage, content, 0644))
+
+	// So are the files of a pallet which isn't generated anymore
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "oldpallet"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "oldpallet", "calls.go"), []byte("package oldpallet\n"), 0644))
+	out, code = runMain(t, dir, "--check", fixture, "example.com/minimal")
+	require.Equal(t, 1, code, out)
+	require.Equal(t, "--- oldpallet/calls.go\n+++ /dev/null\n@@ -1,1 +0,0 @@\n-package oldpallet\n1 generated files are out of date\n", out)
+}
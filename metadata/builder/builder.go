@@ -0,0 +1,562 @@
+// Package builder declares synthetic v14 metadata in Go, for tests and fixtures, instead of
+// hex-encoding SCALE metadata by hand. Types are declared first, then pallets using them:
+//
+//	b := builder.New("my_runtime")
+//	u32 := b.Primitive(types.IsU32)
+//	account := b.Composite("pallet_things::Account", builder.F("nonce", u32))
+//	b.Pallet("Things", 1).
+//		Call("bump", builder.F("by", u32)).
+//		Event("Bumped", builder.F("to", u32)).
+//		Map("Accounts", u32, account, builder.Blake2_128Concat)
+//	meta, err := b.Build()
+//
+// The runtime's RuntimeCall and RuntimeEvent types are built from the pallets' calls and events.
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/aphoh/go-substrate-gen/metadata"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// The id of a type in the metadata's type registry
+type TypeId = types.Si1LookupTypeID
+
+// The storage hashers
+var (
+	Blake2_128       = types.StorageHasherV10{IsBlake2_128: true}
+	Blake2_256       = types.StorageHasherV10{IsBlake2_256: true}
+	Blake2_128Concat = types.StorageHasherV10{IsBlake2_128Concat: true}
+	Twox128          = types.StorageHasherV10{IsTwox128: true}
+	Twox256          = types.StorageHasherV10{IsTwox256: true}
+	Twox64Concat     = types.StorageHasherV10{IsTwox64Concat: true}
+	Identity         = types.StorageHasherV10{IsIdentity: true}
+)
+
+// A field of a composite type, or of a variant, call, event or error. Fields without a name are
+// unnamed, like those of tuple structs. The TypeName is the Rust type as written in the source,
+// e.g. Box<<T as Config>::RuntimeCall>, which decides whether the generated field is a pointer.
+type Field struct {
+	Name     string
+	Type     TypeId
+	TypeName string
+	Docs     []string
+}
+
+// Get a field with a name and type
+func F(name string, ty TypeId) Field {
+	return Field{Name: name, Type: ty}
+}
+
+// A variant of a variant type
+type Variant struct {
+	Name   string
+	Index  uint8
+	Fields []Field
+	Docs   []string
+}
+
+// Get a variant with a name, index and fields
+func V(name string, index uint8, fields ...Field) Variant {
+	return Variant{Name: name, Index: index, Fields: fields}
+}
+
+// A generic parameter of a type, like the T of Option<T>
+type Param struct {
+	Name string
+	Type TypeId
+}
+
+// Builds the metadata of a runtime
+type Builder struct {
+	runtime string
+	types   []types.PortableTypeV14
+	// Which types have been defined. Types are reserved when they're declared, and defined once
+	// their definition is given
+	defined []bool
+	// Types without a path or params, which are declared once
+	anonymous map[string]TypeId
+	pallets   []*PalletBuilder
+
+	callId, eventId TypeId
+	extrinsic       *types.ExtrinsicV14
+	// The first error, returned by Build
+	err error
+}
+
+// Start the metadata of a runtime, whose crate is named `runtime`, e.g. node_runtime
+func New(runtime string) *Builder {
+	b := &Builder{runtime: runtime, anonymous: map[string]TypeId{}}
+	b.callId = b.Type(runtime + "::RuntimeCall").Id()
+	b.eventId = b.Type(runtime + "::RuntimeEvent").Id()
+	return b
+}
+
+// The runtime's call type, which is a variant of the calls of each pallet
+func (b *Builder) RuntimeCall() TypeId {
+	return b.callId
+}
+
+// The runtime's event type, which is a variant of the events of each pallet
+func (b *Builder) RuntimeEvent() TypeId {
+	return b.eventId
+}
+
+// Declare a type with a path like sp_core::crypto::AccountId32, or "" for an anonymous type. Its id
+// is reserved straight away, so recursive types can refer to themselves before they're defined.
+func (b *Builder) Type(path string) *TypeBuilder {
+	p := types.Si1Path{}
+	if path != "" {
+		for _, s := range strings.Split(path, "::") {
+			p = append(p, types.Text(s))
+		}
+	}
+	id := types.NewSi1LookupTypeIDFromUInt(uint64(len(b.types)))
+	b.types = append(b.types, types.PortableTypeV14{ID: id, Type: types.Si1Type{Path: p}})
+	b.defined = append(b.defined, false)
+	return &TypeBuilder{b: b, id: id}
+}
+
+// Get a primitive type
+func (b *Builder) Primitive(p types.Si0TypeDefPrimitive) TypeId {
+	return b.anon(types.Si1TypeDef{IsPrimitive: true, Primitive: types.Si1TypeDefPrimitive{Si0TypeDefPrimitive: p}})
+}
+
+// Get a Vec<T> of a type
+func (b *Builder) Sequence(of TypeId) TypeId {
+	return b.anon(types.Si1TypeDef{IsSequence: true, Sequence: types.Si1TypeDefSequence{Type: of}})
+}
+
+// Get a [T; n] of a type
+func (b *Builder) Array(n uint32, of TypeId) TypeId {
+	return b.anon(types.Si1TypeDef{IsArray: true, Array: types.Si1TypeDefArray{Len: types.U32(n), Type: of}})
+}
+
+// Get a tuple of types, or () if there are none
+func (b *Builder) Tuple(of ...TypeId) TypeId {
+	return b.anon(types.Si1TypeDef{IsTuple: true, Tuple: append(types.Si1TypeDefTuple{}, of...)})
+}
+
+// Get a Compact<T> of a type
+func (b *Builder) Compact(of TypeId) TypeId {
+	return b.anon(types.Si1TypeDef{IsCompact: true, Compact: types.Si1TypeDefCompact{Type: of}})
+}
+
+// Get a BitVec<Store, Order>, of the store type, e.g. u8, and the order type, e.g. bitvec::order::Lsb0
+func (b *Builder) BitSequence(store, order TypeId) TypeId {
+	return b.anon(types.Si1TypeDef{IsBitSequence: true, BitSequence: types.Si1TypeDefBitSequence{BitStoreType: store, BitOrderType: order}})
+}
+
+// Declare a composite type. See TypeBuilder.Composite
+func (b *Builder) Composite(path string, fields ...Field) TypeId {
+	return b.Type(path).Composite(fields...)
+}
+
+// Declare a variant type. See TypeBuilder.Variant
+func (b *Builder) Variant(path string, variants ...Variant) TypeId {
+	return b.Type(path).Variant(variants...)
+}
+
+// Get an Option<T> of a type
+func (b *Builder) Option(of TypeId) TypeId {
+	key := fmt.Sprintf("Option<%v>", of.Int64())
+	if id, ok := b.anonymous[key]; ok {
+		return id
+	}
+	id := b.Type("Option").Params(Param{"T", of}).Variant(V("None", 0), V("Some", 1, F("", of)))
+	b.anonymous[key] = id
+	return id
+}
+
+// Get an anonymous type, declaring it only once
+func (b *Builder) anon(def types.Si1TypeDef) TypeId {
+	key := fmt.Sprintf("%+v", def)
+	if id, ok := b.anonymous[key]; ok {
+		return id
+	}
+	id := b.Type("").define(def)
+	b.anonymous[key] = id
+	return id
+}
+
+// Set the extrinsic type to an UncheckedExtrinsic of the given address and signature types, at
+// format version 4. Without an extrinsic type, the metadata's extrinsic has no Address and Signature
+// params, so no extrinsic builder can be generated for it.
+func (b *Builder) Extrinsic(address, signature TypeId) *Builder {
+	b.extrinsic = &types.ExtrinsicV14{
+		Type: b.Type("sp_runtime::generic::unchecked_extrinsic::UncheckedExtrinsic").
+			Params(Param{"Address", address}, Param{"Call", b.callId}, Param{"Signature", signature}).
+			Sequence(b.Primitive(types.IsU8)),
+		Version: 4,
+	}
+	return b
+}
+
+// Add a signed extension to the extrinsic, with the type of its extra data and of its additional
+// signed data. Extrinsic must be called first.
+func (b *Builder) SignedExtension(identifier string, ty, additionalSigned TypeId) *Builder {
+	if b.extrinsic == nil {
+		b.fail(fmt.Errorf("signed extension %v added before the extrinsic type", identifier))
+		return b
+	}
+	b.extrinsic.SignedExtensions = append(b.extrinsic.SignedExtensions, types.SignedExtensionMetadataV14{
+		Identifier:       types.Text(identifier),
+		Type:             ty,
+		AdditionalSigned: additionalSigned,
+	})
+	return b
+}
+
+// Add a pallet, with its name and index in the runtime. The pallet's calls, events and errors are
+// types in a crate named after it, like pallet_balances for Balances, unless changed with Crate.
+func (b *Builder) Pallet(name string, index uint8) *PalletBuilder {
+	p := &PalletBuilder{b: b, name: name, index: index, crate: "pallet_" + snakeCase(name)}
+	b.pallets = append(b.pallets, p)
+	return p
+}
+
+func (b *Builder) fail(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// Build the metadata. The builder can still be used afterwards, and built again.
+func (b *Builder) Build() (*types.MetadataV14, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	// The pallets' types are added to a copy of the registry, so building again gives the same ids
+	reg := &Builder{runtime: b.runtime, types: append([]types.PortableTypeV14{}, b.types...), defined: append([]bool{}, b.defined...), anonymous: map[string]TypeId{}}
+	for k, v := range b.anonymous {
+		reg.anonymous[k] = v
+	}
+
+	pallets := []types.PalletMetadataV14{}
+	calls := []Variant{}
+	events := []Variant{}
+	for _, p := range b.pallets {
+		pm, err := p.build(reg)
+		if err != nil {
+			return nil, fmt.Errorf("pallet %v: %v", p.name, err)
+		}
+		if pm.HasCalls {
+			calls = append(calls, Variant{Name: p.name, Index: p.index, Fields: []Field{
+				{Type: pm.Calls.Type, TypeName: fmt.Sprintf("self::sp_api_hidden_includes_construct_runtime::hidden_include::dispatch::CallableCallFor<%v, Runtime>", p.name)},
+			}})
+		}
+		if pm.HasEvents {
+			events = append(events, Variant{Name: p.name, Index: p.index, Fields: []Field{
+				{Type: pm.Events.Type, TypeName: fmt.Sprintf("%v::Event<Runtime>", p.crate)},
+			}})
+		}
+		pallets = append(pallets, pm)
+	}
+	(&TypeBuilder{b: reg, id: b.callId}).Variant(calls...)
+	(&TypeBuilder{b: reg, id: b.eventId}).Variant(events...)
+	runtime := reg.Composite(b.runtime + "::Runtime")
+	if reg.err != nil {
+		return nil, reg.err
+	}
+
+	for i, defined := range reg.defined {
+		if !defined {
+			return nil, fmt.Errorf("type id=%v (%v) is declared but never defined", i, pathString(reg.types[i].Type.Path))
+		}
+	}
+	extrinsic := types.ExtrinsicV14{Version: 4}
+	if b.extrinsic != nil {
+		extrinsic = *b.extrinsic
+		extrinsic.SignedExtensions = append([]types.SignedExtensionMetadataV14{}, b.extrinsic.SignedExtensions...)
+	} else {
+		extrinsic.Type = reg.Type("sp_runtime::generic::unchecked_extrinsic::UncheckedExtrinsic").
+			Params(Param{"Call", b.callId}).
+			Sequence(reg.Primitive(types.IsU8))
+	}
+	return &types.MetadataV14{
+		Lookup:    types.PortableRegistryV14{Types: reg.types},
+		Pallets:   pallets,
+		Extrinsic: extrinsic,
+		Type:      runtime,
+	}, nil
+}
+
+// Build the metadata, and SCALE-encode it as the hex string returned by the state_getMetadata RPC
+func (b *Builder) Encode() (string, error) {
+	meta, err := b.Build()
+	if err != nil {
+		return "", err
+	}
+	return codec.EncodeToHex(types.Metadata{MagicNumber: types.MagicNumber, Version: 14, AsMetadataV14: *meta})
+}
+
+// Build the metadata as a state_getMetadata JSON-RPC response, which metadata.ParseMetadata and the
+// generator read
+func (b *Builder) JSON() ([]byte, error) {
+	enc, err := b.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(metadata.MetaResp{JsonRPC: "2.0", Result: enc, Id: 1}, "", "  ")
+}
+
+// A declared type, whose definition is given by calling one of Composite, Variant, Sequence,
+// Array, Tuple, Compact, BitSequence or Primitive
+type TypeBuilder struct {
+	b  *Builder
+	id TypeId
+}
+
+// The id of the type, which can be used before it's defined
+func (t *TypeBuilder) Id() TypeId {
+	return t.id
+}
+
+// Set the generic parameters of the type
+func (t *TypeBuilder) Params(params ...Param) *TypeBuilder {
+	ps := []types.Si1TypeParameter{}
+	for _, p := range params {
+		ps = append(ps, types.Si1TypeParameter{Name: types.Text(p.Name), HasType: true, Type: p.Type})
+	}
+	t.b.types[t.id.Int64()].Type.Params = ps
+	return t
+}
+
+// Set the docs of the type
+func (t *TypeBuilder) Docs(docs ...string) *TypeBuilder {
+	t.b.types[t.id.Int64()].Type.Docs = texts(docs)
+	return t
+}
+
+// Define the type as a struct, or a tuple struct if its fields have no names
+func (t *TypeBuilder) Composite(fields ...Field) TypeId {
+	return t.define(types.Si1TypeDef{IsComposite: true, Composite: types.Si1TypeDefComposite{Fields: si1Fields(fields)}})
+}
+
+// Define the type as an enum
+func (t *TypeBuilder) Variant(variants ...Variant) TypeId {
+	vs := []types.Si1Variant{}
+	for _, v := range variants {
+		vs = append(vs, types.Si1Variant{Name: types.Text(v.Name), Index: types.U8(v.Index), Fields: si1Fields(v.Fields), Docs: texts(v.Docs)})
+	}
+	return t.define(types.Si1TypeDef{IsVariant: true, Variant: types.Si1TypeDefVariant{Variants: vs}})
+}
+
+// Define the type as a Vec<T>
+func (t *TypeBuilder) Sequence(of TypeId) TypeId {
+	return t.define(types.Si1TypeDef{IsSequence: true, Sequence: types.Si1TypeDefSequence{Type: of}})
+}
+
+// Define the type as a [T; n]
+func (t *TypeBuilder) Array(n uint32, of TypeId) TypeId {
+	return t.define(types.Si1TypeDef{IsArray: true, Array: types.Si1TypeDefArray{Len: types.U32(n), Type: of}})
+}
+
+// Define the type as a tuple
+func (t *TypeBuilder) Tuple(of ...TypeId) TypeId {
+	return t.define(types.Si1TypeDef{IsTuple: true, Tuple: append(types.Si1TypeDefTuple{}, of...)})
+}
+
+// Define the type as a Compact<T>
+func (t *TypeBuilder) Compact(of TypeId) TypeId {
+	return t.define(types.Si1TypeDef{IsCompact: true, Compact: types.Si1TypeDefCompact{Type: of}})
+}
+
+// Define the type as a BitVec<Store, Order>
+func (t *TypeBuilder) BitSequence(store, order TypeId) TypeId {
+	return t.define(types.Si1TypeDef{IsBitSequence: true, BitSequence: types.Si1TypeDefBitSequence{BitStoreType: store, BitOrderType: order}})
+}
+
+// Define the type as a primitive
+func (t *TypeBuilder) Primitive(p types.Si0TypeDefPrimitive) TypeId {
+	return t.define(types.Si1TypeDef{IsPrimitive: true, Primitive: types.Si1TypeDefPrimitive{Si0TypeDefPrimitive: p}})
+}
+
+func (t *TypeBuilder) define(def types.Si1TypeDef) TypeId {
+	i := t.id.Int64()
+	if t.b.defined[i] {
+		t.b.fail(fmt.Errorf("type id=%v (%v) is defined twice", i, pathString(t.b.types[i].Type.Path)))
+	}
+	t.b.types[i].Type.Def = def
+	t.b.defined[i] = true
+	return t.id
+}
+
+// Builds a pallet. Calls, events and errors are indexed in the order they're added.
+type PalletBuilder struct {
+	b     *Builder
+	name  string
+	index uint8
+	crate string
+
+	calls, events, errors []Variant
+	storage               []types.StorageEntryMetadataV14
+	constants             []types.ConstantMetadataV14
+}
+
+// Set the crate of the pallet's call, event and error types, like frame_system for System
+func (p *PalletBuilder) Crate(crate string) *PalletBuilder {
+	p.crate = crate
+	return p
+}
+
+// Add a call, whose fields are its arguments
+func (p *PalletBuilder) Call(name string, fields ...Field) *PalletBuilder {
+	p.calls = append(p.calls, V(name, uint8(len(p.calls)), fields...))
+	return p
+}
+
+// Add an event
+func (p *PalletBuilder) Event(name string, fields ...Field) *PalletBuilder {
+	p.events = append(p.events, V(name, uint8(len(p.events)), fields...))
+	return p
+}
+
+// Add an error
+func (p *PalletBuilder) Error(name string, docs ...string) *PalletBuilder {
+	p.errors = append(p.errors, Variant{Name: name, Index: uint8(len(p.errors)), Docs: docs})
+	return p
+}
+
+// Add a constant, whose value is SCALE-encoded
+func (p *PalletBuilder) Constant(name string, ty TypeId, value interface{}, docs ...string) *PalletBuilder {
+	enc, err := codec.Encode(value)
+	if err != nil {
+		p.b.fail(fmt.Errorf("error encoding constant %v.%v: %v", p.name, name, err))
+	}
+	p.constants = append(p.constants, types.ConstantMetadataV14{Name: types.Text(name), Type: ty, Value: enc, Docs: texts(docs)})
+	return p
+}
+
+// Add a storage value. Like a StorageValue with an OptionQuery, it's None until set, unless
+// Default is called.
+func (p *PalletBuilder) Value(name string, value TypeId) *StorageBuilder {
+	return p.addStorage(name, types.StorageEntryTypeV14{IsPlainType: true, AsPlainType: value})
+}
+
+// Add a storage map, with one hasher for each part of the key. A key with several hashers is a
+// tuple, which is hashed element by element, like the keys of StorageDoubleMaps and StorageNMaps.
+func (p *PalletBuilder) Map(name string, key, value TypeId, hashers ...types.StorageHasherV10) *StorageBuilder {
+	return p.addStorage(name, types.StorageEntryTypeV14{IsMap: true, AsMap: types.MapTypeV14{
+		Hashers: append([]types.StorageHasherV10{}, hashers...),
+		Key:     key,
+		Value:   value,
+	}})
+}
+
+func (p *PalletBuilder) addStorage(name string, ty types.StorageEntryTypeV14) *StorageBuilder {
+	p.storage = append(p.storage, types.StorageEntryMetadataV14{
+		Name:     types.Text(name),
+		Modifier: types.StorageFunctionModifierV0{IsOptional: true},
+		Type:     ty,
+		// An encoded None
+		Fallback: types.Bytes{0},
+	})
+	return &StorageBuilder{p: p, i: len(p.storage) - 1}
+}
+
+// Build the pallet, adding its call, event and error types to the registry
+func (p *PalletBuilder) build(reg *Builder) (types.PalletMetadataV14, error) {
+	pm := types.PalletMetadataV14{
+		Name:      types.Text(p.name),
+		Index:     types.U8(p.index),
+		Constants: append([]types.ConstantMetadataV14{}, p.constants...),
+	}
+	if len(p.storage) > 0 {
+		pm.HasStorage = true
+		pm.Storage = types.StorageMetadataV14{Prefix: types.Text(p.name), Items: append([]types.StorageEntryMetadataV14{}, p.storage...)}
+		for _, item := range p.storage {
+			if item.Type.IsMap && len(item.Type.AsMap.Hashers) == 0 {
+				return pm, fmt.Errorf("storage map %v has no hashers", item.Name)
+			}
+		}
+	}
+	if len(p.calls) > 0 {
+		pm.HasCalls = true
+		pm.Calls.Type = reg.Variant(p.crate+"::pallet::Call", p.calls...)
+	}
+	if len(p.events) > 0 {
+		pm.HasEvents = true
+		pm.Events.Type = reg.Variant(p.crate+"::pallet::Event", p.events...)
+	}
+	if len(p.errors) > 0 {
+		pm.HasErrors = true
+		pm.Errors.Type = reg.Variant(p.crate+"::pallet::Error", p.errors...)
+	}
+	return pm, nil
+}
+
+// Builds a storage entry of a pallet
+type StorageBuilder struct {
+	p *PalletBuilder
+	i int
+}
+
+// Make the entry a ValueQuery, whose value is `value` until set. The value is SCALE-encoded.
+func (s *StorageBuilder) Default(value interface{}) *StorageBuilder {
+	item := &s.p.storage[s.i]
+	enc, err := codec.Encode(value)
+	if err != nil {
+		s.p.b.fail(fmt.Errorf("error encoding the default of %v.%v: %v", s.p.name, item.Name, err))
+	}
+	item.Modifier = types.StorageFunctionModifierV0{IsDefault: true}
+	item.Fallback = enc
+	return s
+}
+
+// Set the docs of the entry
+func (s *StorageBuilder) Docs(docs ...string) *StorageBuilder {
+	s.p.storage[s.i].Documentation = texts(docs)
+	return s
+}
+
+// Get the pallet, to continue building it
+func (s *StorageBuilder) Pallet() *PalletBuilder {
+	return s.p
+}
+
+func si1Fields(fields []Field) []types.Si1Field {
+	fs := []types.Si1Field{}
+	for _, f := range fields {
+		fs = append(fs, types.Si1Field{
+			HasName:     f.Name != "",
+			Name:        types.Text(f.Name),
+			Type:        f.Type,
+			HasTypeName: f.TypeName != "",
+			TypeName:    types.Text(f.TypeName),
+			Docs:        texts(f.Docs),
+		})
+	}
+	return fs
+}
+
+func texts(strs []string) []types.Text {
+	ts := []types.Text{}
+	for _, s := range strs {
+		ts = append(ts, types.Text(s))
+	}
+	return ts
+}
+
+func pathString(p types.Si1Path) string {
+	strs := []string{}
+	for _, s := range p {
+		strs = append(strs, string(s))
+	}
+	if len(strs) == 0 {
+		return "anonymous"
+	}
+	return strings.Join(strs, "::")
+}
+
+var upper = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// Turn a pallet name like ElectionProviderMultiPhase into election_provider_multi_phase
+func snakeCase(s string) string {
+	return strings.ToLower(upper.ReplaceAllString(s, "${1}_${2}"))
+}
@@ -0,0 +1,77 @@
+package builder
+
+import (
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/metadata"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	b := New("test_runtime")
+	u32 := b.Primitive(types.IsU32)
+	require.Equal(t, u32, b.Primitive(types.IsU32), "anonymous types are declared once")
+	account := b.Composite("pallet_things::Account", F("nonce", u32))
+	b.Pallet("Things", 3).
+		Call("bump", F("by", u32)).
+		Call("bump_all", F("by", b.Sequence(u32))).
+		Event("Bumped", F("to", u32)).
+		Error("Overflow").
+		Constant("Max", u32, uint32(7)).
+		Map("Accounts", u32, account, Blake2_128Concat).
+		Pallet().
+		Value("Total", u32).Default(uint32(1))
+
+	meta, err := b.Build()
+	require.NoError(t, err)
+	// Building again gives the same metadata
+	again, err := b.Build()
+	require.NoError(t, err)
+	require.Equal(t, meta, again)
+
+	require.Len(t, meta.Pallets, 1)
+	p := meta.Pallets[0]
+	require.Equal(t, types.Text("Things"), p.Name)
+	require.Equal(t, types.U8(3), p.Index)
+	require.True(t, p.HasCalls && p.HasEvents && p.HasErrors && p.HasStorage)
+	calls := meta.Lookup.Types[p.Calls.Type.Int64()].Type
+	require.Equal(t, types.Si1Path{"pallet_things", "pallet", "Call"}, calls.Path)
+	require.Equal(t, types.U8(1), calls.Def.Variant.Variants[1].Index)
+	require.Equal(t, types.Bytes{7, 0, 0, 0}, p.Constants[0].Value)
+	require.True(t, p.Storage.Items[0].Modifier.IsOptional)
+	require.True(t, p.Storage.Items[1].Modifier.IsDefault)
+	require.Equal(t, types.Bytes{1, 0, 0, 0}, p.Storage.Items[1].Fallback)
+
+	// The runtime call is a variant of the pallets' calls, at their index
+	callId := b.RuntimeCall()
+	rtc := meta.Lookup.Types[callId.Int64()].Type
+	require.Equal(t, types.Si1Path{"test_runtime", "RuntimeCall"}, rtc.Path)
+	require.Equal(t, types.U8(3), rtc.Def.Variant.Variants[0].Index)
+	require.Equal(t, p.Calls.Type, rtc.Def.Variant.Variants[0].Fields[0].Type)
+
+	// The JSON is read like a node's metadata
+	js, err := b.JSON()
+	require.NoError(t, err)
+	parsed, _, err := metadata.ParseMetadata(js)
+	require.NoError(t, err)
+	require.Equal(t, len(meta.Lookup.Types), len(parsed.Lookup.Types))
+	require.Equal(t, meta.Pallets[0].Storage, parsed.Pallets[0].Storage)
+}
+
+func TestBuildErrors(t *testing.T) {
+	b := New("test_runtime")
+	b.Type("pallet_things::Forgotten")
+	_, err := b.Build()
+	require.EqualError(t, err, "type id=2 (pallet_things::Forgotten) is declared but never defined")
+
+	b = New("test_runtime")
+	b.SignedExtension("CheckNonce", b.Tuple(), b.Tuple())
+	_, err = b.Build()
+	require.EqualError(t, err, "signed extension CheckNonce added before the extrinsic type")
+
+	b = New("test_runtime")
+	b.Pallet("Things", 0).Map("Accounts", b.Primitive(types.IsU32), b.Primitive(types.IsU32))
+	_, err = b.Build()
+	require.EqualError(t, err, "pallet Things: storage map Accounts has no hashers")
+}
@@ -0,0 +1,495 @@
+// Package metadiff compares two runtime metadata and reports the changes to the pallets, calls,
+// storage entries, events, constants and types of the code generated from them. Changes are
+// breaking if code using the API generated from the old metadata may not compile against the API
+// generated from the new one, e.g. a removed call or a changed argument type. Other changes only
+// need the code to be regenerated, e.g. a new call or a changed call index.
+//
+// Types are matched by their Rust names, their path and params, rather than their go names, which
+// get numeric suffixes in the order types are generated. A matched type whose go name changes, e.g.
+// because an added type took its name, is still breaking, since code using the old name may no
+// longer compile.
+package metadiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/aphoh/go-substrate-gen/palletgen"
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// The package the API is generated into while diffing. Types are named relative to it, e.g.
+// types.AccountData
+const pkgPath = "github.com/aphoh/go-substrate-gen/metadiff/gen"
+
+// A change to the generated API
+type Change struct {
+	// "pallet", "call", "storage", "event", "constant" or "type"
+	Kind string `json:"kind"`
+	// The name of the changed item, e.g. "Balances", "Balances.transfer" or "AccountData"
+	Name string `json:"name"`
+	// "added", "removed" or "changed"
+	Change   string `json:"change"`
+	Breaking bool   `json:"breaking"`
+	// What changed, for changed items
+	Detail string `json:"detail,omitempty"`
+}
+
+// The changes between two metadata, sorted by kind then name
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+// Get the breaking changes
+func (r *Report) Breaking() []Change {
+	res := []Change{}
+	for _, c := range r.Changes {
+		if c.Breaking {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// Format the report for humans, one change per line, followed by a summary
+func (r *Report) String() string {
+	var b strings.Builder
+	for _, c := range r.Changes {
+		level := "non-breaking"
+		if c.Breaking {
+			level = "BREAKING"
+		}
+		fmt.Fprintf(&b, "%-12v %-8v %v %v", level, c.Kind, c.Name, c.Change)
+		if c.Detail != "" {
+			fmt.Fprintf(&b, ": %v", c.Detail)
+		}
+		b.WriteString("\n")
+	}
+	fmt.Fprintf(&b, "%v breaking, %v non-breaking changes\n", len(r.Breaking()), len(r.Changes)-len(r.Breaking()))
+	return b.String()
+}
+
+// Format the report as indented JSON
+func (r *Report) JSON() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// Compare the API generated from two metadata
+func Diff(old, new *types.MetadataV14) (*Report, error) {
+	oldApi, err := generatedApi(old)
+	if err != nil {
+		return nil, fmt.Errorf("old metadata: %v", err)
+	}
+	newApi, err := generatedApi(new)
+	if err != nil {
+		return nil, fmt.Errorf("new metadata: %v", err)
+	}
+
+	r := &Report{Changes: []Change{}}
+	for name := range oldApi.pallets {
+		if !newApi.pallets[name] {
+			r.Changes = append(r.Changes, Change{Kind: "pallet", Name: name, Change: "removed", Breaking: true})
+		}
+	}
+	for name := range newApi.pallets {
+		if !oldApi.pallets[name] {
+			r.Changes = append(r.Changes, Change{Kind: "pallet", Name: name, Change: "added"})
+		}
+	}
+	r.diffItems("call", oldApi.calls, newApi.calls, oldApi.pallets, newApi.pallets, true)
+	r.diffItems("storage", oldApi.storage, newApi.storage, oldApi.pallets, newApi.pallets, true)
+	r.diffItems("event", oldApi.events, newApi.events, oldApi.pallets, newApi.pallets, true)
+	// Constants aren't part of the generated API
+	r.diffItems("constant", oldApi.constants, newApi.constants, oldApi.pallets, newApi.pallets, false)
+	r.diffTypes(oldApi.types, newApi.types)
+
+	kinds := map[string]int{"pallet": 0, "call": 1, "storage": 2, "event": 3, "constant": 4, "type": 5}
+	sort.SliceStable(r.Changes, func(i, j int) bool {
+		ci, cj := r.Changes[i], r.Changes[j]
+		if ci.Kind != cj.Kind {
+			return kinds[ci.Kind] < kinds[cj.Kind]
+		}
+		return ci.Name < cj.Name
+	})
+	return r, nil
+}
+
+// An item of a pallet in the generated API
+type item struct {
+	// The item's signature, with the Rust names of its types. Changing it is breaking
+	signature string
+	// The item's go signature, which is breaking to change too, e.g. when a type is renamed
+	display string
+	// Details which change the encoding but not the go signature, like indices
+	encoding string
+}
+
+// A field of a generated type
+type field struct {
+	name string
+	// The Rust name of the field's type, and its go type
+	rust   string
+	goType string
+}
+
+// A type defined in the types package
+type definedType struct {
+	// The go name
+	name   string
+	fields []field
+}
+
+// The API generated from a metadata. Pallet items are keyed by "Pallet.item"
+type api struct {
+	pallets   map[string]bool
+	calls     map[string]item
+	storage   map[string]item
+	events    map[string]item
+	constants map[string]item
+	// The types defined in the types package, by Rust name
+	types map[string]definedType
+}
+
+// Compare the items of one kind. The changes to the items of added and removed pallets are left
+// out, as the pallet's change covers them.
+func (r *Report) diffItems(kind string, old, new map[string]item, oldPallets, newPallets map[string]bool, inApi bool) {
+	pallet := func(name string) string {
+		return strings.SplitN(name, ".", 2)[0]
+	}
+	for name, o := range old {
+		if !newPallets[pallet(name)] {
+			continue
+		}
+		n, ok := new[name]
+		switch {
+		case !ok:
+			r.Changes = append(r.Changes, Change{Kind: kind, Name: name, Change: "removed", Breaking: inApi})
+		case o.signature != n.signature || o.display != n.display:
+			detail := fmt.Sprintf("%v -> %v", o.display, n.display)
+			if o.display == n.display {
+				// The go types are named alike, but are different types
+				detail = fmt.Sprintf("%v -> %v", o.signature, n.signature)
+			}
+			r.Changes = append(r.Changes, Change{
+				Kind: kind, Name: name, Change: "changed", Breaking: inApi, Detail: detail,
+			})
+		case o.encoding != n.encoding:
+			r.Changes = append(r.Changes, Change{
+				Kind: kind, Name: name, Change: "changed",
+				Detail: fmt.Sprintf("%v -> %v", o.encoding, n.encoding),
+			})
+		}
+	}
+	for name := range new {
+		if _, ok := old[name]; !ok && oldPallets[pallet(name)] {
+			r.Changes = append(r.Changes, Change{Kind: kind, Name: name, Change: "added"})
+		}
+	}
+}
+
+// Compare the fields of the generated types. Removing or renaming a type or a field, or changing a
+// field's type is breaking, but adding fields isn't, e.g. a new variant of an enum. Types are matched
+// by their Rust names, and changes are named by their old go names.
+func (r *Report) diffTypes(old, new map[string]definedType) {
+	for rust, o := range old {
+		n, ok := new[rust]
+		if !ok {
+			r.Changes = append(r.Changes, Change{Kind: "type", Name: o.name, Change: "removed", Breaking: true})
+			continue
+		}
+		nFields := map[string]field{}
+		for _, f := range n.fields {
+			nFields[f.name] = f
+		}
+		oFields := map[string]bool{}
+		details := []string{}
+		breaking := false
+		if o.name != n.name {
+			details = append(details, fmt.Sprintf("renamed to %v", n.name))
+			breaking = true
+		}
+		for _, f := range o.fields {
+			oFields[f.name] = true
+			nf, ok := nFields[f.name]
+			if !ok {
+				details = append(details, fmt.Sprintf("removed %v %v", f.name, f.goType))
+				breaking = true
+			} else if nf.rust != f.rust || nf.goType != f.goType {
+				details = append(details, fmt.Sprintf("changed %v %v -> %v", f.name, f.goType, nf.goType))
+				breaking = true
+			}
+		}
+		for _, f := range n.fields {
+			if !oFields[f.name] {
+				details = append(details, fmt.Sprintf("added %v %v", f.name, f.goType))
+			}
+		}
+		if len(details) > 0 {
+			r.Changes = append(r.Changes, Change{
+				Kind: "type", Name: o.name, Change: "changed", Breaking: breaking, Detail: strings.Join(details, ", "),
+			})
+		}
+	}
+	for rust, n := range new {
+		if _, ok := old[rust]; !ok {
+			r.Changes = append(r.Changes, Change{Kind: "type", Name: n.name, Change: "added"})
+		}
+	}
+}
+
+// Generate the pallets' code like the generator does, so types get the same names, and collect the
+// API from it
+func generatedApi(meta *types.MetadataV14) (*api, error) {
+	tg := typegen.NewTypeGenerator(meta, "", path.Join(pkgPath, "/types"))
+	tg.GenerateBackend()
+	for _, pallet := range meta.Pallets {
+		pg := palletgen.NewPalletGenerator(&pallet, &tg)
+		palletPath := path.Join(pkgPath, "/"+strings.ToLower(string(pallet.Name)))
+		if _, _, err := pg.GenerateStorage(palletPath); err != nil {
+			return nil, fmt.Errorf("error generating storage for pallet %v: %v", pallet.Name, err)
+		}
+		if _, _, err := pg.GenerateCalls(palletPath); err != nil {
+			return nil, fmt.Errorf("error generating calls for pallet %v: %v", pallet.Name, err)
+		}
+	}
+
+	n := newNamer(meta, &tg)
+	a := &api{
+		pallets:   map[string]bool{},
+		calls:     map[string]item{},
+		storage:   map[string]item{},
+		events:    map[string]item{},
+		constants: map[string]item{},
+		types:     map[string]definedType{},
+	}
+	for _, pallet := range meta.Pallets {
+		pName := string(pallet.Name)
+		a.pallets[pName] = true
+		if pallet.HasCalls {
+			if err := variantItems(n, a.calls, pName, uint8(pallet.Index), pallet.Calls.Type.Int64()); err != nil {
+				return nil, err
+			}
+		}
+		if pallet.HasEvents {
+			if err := variantItems(n, a.events, pName, uint8(pallet.Index), pallet.Events.Type.Int64()); err != nil {
+				return nil, err
+			}
+		}
+		if pallet.HasStorage {
+			for _, st := range pallet.Storage.Items {
+				it, err := storageItem(n, &st)
+				if err != nil {
+					return nil, fmt.Errorf("storage %v.%v: %v", pName, st.Name, err)
+				}
+				a.storage[pName+"."+string(st.Name)] = it
+			}
+		}
+		for _, c := range pallet.Constants {
+			gend, err := tg.GetType(c.Type.Int64())
+			if err != nil {
+				return nil, fmt.Errorf("constant %v.%v: %v", pName, c.Name, err)
+			}
+			a.constants[pName+"."+string(c.Name)] = item{
+				signature: n.rustName(c.Type.Int64()), display: render(gend), encoding: codec.HexEncodeToString(c.Value),
+			}
+		}
+	}
+
+	// Types with the same Rust name, like BoundedVecs whose bound has no type, are told apart by
+	// their order
+	defined := tg.DefinedTypes()
+	names := []string{}
+	for name := range defined {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return defined[names[i]].MType().ID.Int64() < defined[names[j]].MType().ID.Int64()
+	})
+	for _, name := range names {
+		gend := defined[name]
+		fields, err := typeFields(n, gend)
+		if err != nil {
+			return nil, fmt.Errorf("type %v: %v", name, err)
+		}
+		rust := n.rustName(gend.MType().ID.Int64())
+		for i := 2; ; i++ {
+			if _, ok := a.types[rust]; !ok {
+				break
+			}
+			rust = fmt.Sprintf("%v #%v", n.rustName(gend.MType().ID.Int64()), i)
+		}
+		a.types[rust] = definedType{name: name, fields: fields}
+	}
+	return a, nil
+}
+
+// Collect the calls or events of a pallet, the variants of its call or event type. Their signature
+// is their fields' names and types, and their encoding is their index.
+func variantItems(n *namer, res map[string]item, pallet string, palletIndex uint8, typeId int64) error {
+	gend, err := n.tg.GetType(typeId)
+	if err != nil {
+		return err
+	}
+	tdef := gend.MType().Type.Def
+	if !tdef.IsVariant {
+		return nil
+	}
+	for _, v := range tdef.Variant.Variants {
+		args := []string{}
+		goArgs := []string{}
+		for i, f := range v.Fields {
+			rust, goTypes, err := n.flatten(f.Type.Int64())
+			if err != nil {
+				return err
+			}
+			name := string(f.Name)
+			if name == "" {
+				name = fmt.Sprint(i)
+			}
+			args = append(args, strings.TrimSpace(name+" "+strings.Join(rust, ", ")))
+			goArgs = append(goArgs, strings.TrimSpace(name+" "+strings.Join(goTypes, ", ")))
+		}
+		res[pallet+"."+string(v.Name)] = item{
+			signature: "(" + strings.Join(args, ", ") + ")",
+			display:   "(" + strings.Join(goArgs, ", ") + ")",
+			encoding:  fmt.Sprintf("index %v.%v", palletIndex, v.Index),
+		}
+	}
+	return nil
+}
+
+// Collect a storage entry. Its signature is its keys' and value's types, and its encoding its
+// hashers and modifier
+func storageItem(n *namer, st *types.StorageEntryMetadataV14) (item, error) {
+	valueId := st.Type.AsPlainType
+	keys, goKeys := []string{}, []string{}
+	hashers := []string{}
+	if st.Type.IsMap {
+		valueId = st.Type.AsMap.Value
+		var err error
+		keys, goKeys, err = n.flatten(st.Type.AsMap.Key.Int64())
+		if err != nil {
+			return item{}, err
+		}
+		for _, h := range st.Type.AsMap.Hashers {
+			hashers = append(hashers, utils.HasherName(h))
+		}
+	}
+	vGend, err := n.tg.GetType(valueId.Int64())
+	if err != nil {
+		return item{}, err
+	}
+	modifier := "default"
+	if st.Modifier.IsOptional {
+		modifier = "optional"
+	}
+	return item{
+		signature: fmt.Sprintf("(%v) %v", strings.Join(keys, ", "), n.rustName(valueId.Int64())),
+		display:   fmt.Sprintf("(%v) %v", strings.Join(goKeys, ", "), render(vGend)),
+		encoding:  fmt.Sprintf("%v, hashers [%v]", modifier, strings.Join(hashers, " ")),
+	}, nil
+}
+
+// Get the fields of a generated type
+func typeFields(n *namer, gend typegen.GeneratedType) ([]field, error) {
+	tdef := gend.MType().Type.Def
+	newField := func(name string, id types.Si1LookupTypeID, isPtr bool) (field, error) {
+		fGend, err := n.tg.GetType(id.Int64())
+		if err != nil {
+			return field{}, err
+		}
+		f := field{name: name, rust: n.rustName(id.Int64()), goType: render(fGend)}
+		if isPtr {
+			f.rust = "Box<" + f.rust + ">"
+			f.goType = "*" + f.goType
+		}
+		return f, nil
+	}
+
+	res := []field{}
+	switch g := gend.(type) {
+	case *typegen.CompositeGend:
+		for i, f := range g.Fields {
+			nf, err := newField(f.Name, tdef.Composite.Fields[i].Type, f.IsPtr)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, nf)
+		}
+	case *typegen.VariantGend:
+		for i, v := range tdef.Variant.Variants {
+			res = append(res, field{name: g.IsVarFields[i].Name, rust: "bool", goType: "bool"})
+			for k, f := range g.AsVarFields[i] {
+				nf, err := newField(f.Name, v.Fields[k].Type, f.IsPtr)
+				if err != nil {
+					return nil, err
+				}
+				res = append(res, nf)
+			}
+		}
+	default:
+		// Tuples
+		for i, id := range tdef.Tuple {
+			nf, err := newField(fmt.Sprintf("Elem%v", i), id, false)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, nf)
+		}
+	}
+	return res, nil
+}
+
+// Names types by their Rust path and params, e.g. pallet_balances::AccountData<u128>, or by their
+// structure if they have no path, e.g. (u32, Vec<u8>)
+type namer struct {
+	tg    *typegen.TypeGenerator
+	types map[int64]types.PortableTypeV14
+}
+
+func newNamer(meta *types.MetadataV14, tg *typegen.TypeGenerator) *namer {
+	n := &namer{tg: tg, types: map[int64]types.PortableTypeV14{}}
+	for _, mt := range meta.Lookup.Types {
+		n.types[mt.ID.Int64()] = mt
+	}
+	return n
+}
+
+// Get the Rust name of a type
+func (n *namer) rustName(id int64) string {
+	return utils.RustTypeName(n.types, id, -1)
+}
+
+// Get the Rust and go types of the arguments a type is passed as, flattening tuples like
+// GenerateArgs
+func (n *namer) flatten(id int64) ([]string, []string, error) {
+	gend, err := n.tg.GetType(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	tdef := gend.MType().Type.Def
+	if !tdef.IsTuple {
+		return []string{n.rustName(id)}, []string{render(gend)}, nil
+	}
+	rust, goTypes := []string{}, []string{}
+	for _, inner := range tdef.Tuple {
+		r, g, err := n.flatten(inner.Int64())
+		if err != nil {
+			return nil, nil, err
+		}
+		rust = append(rust, r...)
+		goTypes = append(goTypes, g...)
+	}
+	return rust, goTypes, nil
+}
+
+// Render a generated type's go code, e.g. types.AccountData or []byte
+func render(gend typegen.GeneratedType) string {
+	return fmt.Sprintf("%#v", gend.Code())
+}
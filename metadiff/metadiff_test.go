@@ -0,0 +1,142 @@
+package metadiff
+
+import (
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/internal/testmeta"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+)
+
+func findChange(r *Report, kind, name string) *Change {
+	for _, c := range r.Changes {
+		if c.Kind == kind && c.Name == name {
+			return &c
+		}
+	}
+	return nil
+}
+
+func TestDiff(t *testing.T) {
+	report, err := Diff(testmeta.Example(t), testmeta.Example(t))
+	require.NoError(t, err)
+	require.Empty(t, report.Changes)
+
+	new := testmeta.Example(t)
+	var systemIndex int
+	for i, p := range new.Pallets {
+		switch p.Name {
+		case "System":
+			systemIndex = i
+			// Changing a constant's value doesn't change the generated API
+			new.Pallets[i].Constants[0].Value = []byte{1, 2, 3}
+		case "Balances":
+			// Changing a pallet's index changes the encoding of its calls
+			new.Pallets[i].Index = 100
+		}
+	}
+	for i, ty := range new.Lookup.Types {
+		p := ty.Type.Path
+		if len(p) == 2 && p[0] == "node_runtime" && (p[1] == "RuntimeCall" || p[1] == "RuntimeEvent") {
+			for j, v := range ty.Type.Def.Variant.Variants {
+				if v.Name == "Balances" {
+					new.Lookup.Types[i].Type.Def.Variant.Variants[j].Index = 100
+				}
+			}
+		}
+	}
+	// Removing a pallet is breaking
+	removed := string(new.Pallets[len(new.Pallets)-1].Name)
+	new.Pallets = new.Pallets[:len(new.Pallets)-1]
+	// Renaming a call's argument is breaking
+	calls := new.Pallets[systemIndex].Calls.Type.Int64()
+	for i, ty := range new.Lookup.Types {
+		if ty.ID.Int64() != calls {
+			continue
+		}
+		for j, v := range ty.Type.Def.Variant.Variants {
+			if v.Name == "remark" {
+				new.Lookup.Types[i].Type.Def.Variant.Variants[j].Fields[0].Name = "note"
+			}
+		}
+	}
+
+	report, err = Diff(testmeta.Example(t), new)
+	require.NoError(t, err)
+
+	c := findChange(report, "pallet", removed)
+	require.NotNil(t, c)
+	require.True(t, c.Breaking)
+	require.Equal(t, "removed", c.Change)
+
+	c = findChange(report, "call", "System.remark")
+	require.NotNil(t, c)
+	require.True(t, c.Breaking)
+	require.Equal(t, "(remark []byte) -> (note []byte)", c.Detail)
+
+	c = findChange(report, "call", "Balances.transfer")
+	require.NotNil(t, c)
+	require.False(t, c.Breaking)
+	require.Equal(t, "index 6.0 -> index 100.0", c.Detail)
+
+	c = findChange(report, "constant", "System.BlockWeights")
+	require.NotNil(t, c)
+	require.False(t, c.Breaking)
+
+	require.NotEmpty(t, report.Breaking())
+	_, err = report.JSON()
+	require.NoError(t, err)
+}
+
+// Adding a type which takes the go name of another renames it, which is breaking
+func TestDiffAddedType(t *testing.T) {
+	new := testmeta.Example(t)
+	var added types.PortableTypeV14
+	var maxId int64
+	for _, ty := range new.Lookup.Types {
+		if len(ty.Type.Path) == 2 && ty.Type.Path[0] == "pallet_balances" && ty.Type.Path[1] == "AccountData" {
+			added = ty
+		}
+		if ty.ID.Int64() > maxId {
+			maxId = ty.ID.Int64()
+		}
+	}
+	require.NotEmpty(t, added.Type.Path)
+	added.ID = types.NewSi1LookupTypeIDFromUInt(uint64(maxId + 1))
+	added.Type.Path = types.Si1Path{"pallet_other", "AccountData"}
+	new.Lookup.Types = append(new.Lookup.Types, added)
+	// The first storage entry of System is generated before Balances' AccountData, so it takes its
+	// go name
+	for i, p := range new.Pallets {
+		if p.Name == "System" {
+			entry := types.StorageEntryMetadataV14{
+				Name:     "OtherAccount",
+				Modifier: types.StorageFunctionModifierV0{IsOptional: true},
+				Type:     types.StorageEntryTypeV14{IsPlainType: true, AsPlainType: added.ID},
+			}
+			new.Pallets[i].Storage.Items = append([]types.StorageEntryMetadataV14{entry}, p.Storage.Items...)
+		}
+	}
+
+	report, err := Diff(testmeta.Example(t), new)
+	require.NoError(t, err)
+	require.Len(t, report.Breaking(), 3, report.String())
+	c := findChange(report, "storage", "System.OtherAccount")
+	require.NotNil(t, c)
+	require.Equal(t, "added", c.Change)
+	// Balances' AccountData is matched by its Rust name, and renamed
+	c = findChange(report, "type", "AccountData")
+	require.NotNil(t, c)
+	require.Equal(t, Change{Kind: "type", Name: "AccountData", Change: "changed", Breaking: true, Detail: "renamed to AccountData1"}, *c)
+	// And pallet_other's takes its name
+	require.Contains(t, report.Changes, Change{Kind: "type", Name: "AccountData", Change: "added"})
+	// So are the items and fields using it
+	c = findChange(report, "storage", "Balances.Account")
+	require.NotNil(t, c)
+	require.True(t, c.Breaking)
+	require.Equal(t, "([32]byte) types.AccountData -> ([32]byte) types.AccountData1", c.Detail)
+	c = findChange(report, "type", "AccountInfo")
+	require.NotNil(t, c)
+	require.True(t, c.Breaking)
+	require.Equal(t, "changed Data types.AccountData -> types.AccountData1", c.Detail)
+}
@@ -0,0 +1,283 @@
+// Package metahash computes structural hashes of the calls, storage entries and events in runtime
+// metadata, which tell whether code generated from one metadata works with another. Type ids differ between
+// metadata, so types are hashed by their structure: their kind, field and variant names, variant
+// indices and the hashes of the types they contain. Rust type names and docs aren't hashed, so
+// renaming a type keeps it compatible.
+//
+// The generator embeds these hashes in generated code, along with a copy of the hasher which
+// checks them against a live node's metadata, so the two must hash metadata the same way.
+package metahash
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+
+	gsrpchash "github.com/centrifuge/go-substrate-rpc-client/v4/hash"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// The hashes of a pallet's calls, storage entries and events, keyed by name. Hashes are 0x-prefixed
+// hex strings.
+type PalletHashes struct {
+	Calls   map[string]string
+	Storage map[string]string
+	Events  map[string]string
+}
+
+// The hashes of every pallet, keyed by pallet name
+type Hashes map[string]PalletHashes
+
+// Hash every call, storage entry and event in the metadata
+func Hash(meta *types.MetadataV14) (Hashes, error) {
+	h := newHasher(meta)
+	res := Hashes{}
+	for _, pallet := range meta.Pallets {
+		ph := PalletHashes{Calls: map[string]string{}, Storage: map[string]string{}, Events: map[string]string{}}
+		if pallet.HasCalls {
+			if err := h.variantHashes(ph.Calls, uint8(pallet.Index), pallet.Calls.Type.Int64()); err != nil {
+				return nil, fmt.Errorf("calls of pallet %v: %v", pallet.Name, err)
+			}
+		}
+		if pallet.HasEvents {
+			if err := h.variantHashes(ph.Events, uint8(pallet.Index), pallet.Events.Type.Int64()); err != nil {
+				return nil, fmt.Errorf("events of pallet %v: %v", pallet.Name, err)
+			}
+		}
+		if pallet.HasStorage {
+			for _, item := range pallet.Storage.Items {
+				sh, err := h.storageHash(string(pallet.Storage.Prefix), &item)
+				if err != nil {
+					return nil, fmt.Errorf("storage %v.%v: %v", pallet.Name, item.Name, err)
+				}
+				ph.Storage[string(item.Name)] = sh
+			}
+		}
+		res[string(pallet.Name)] = ph
+	}
+	return res, nil
+}
+
+type hasher struct {
+	types map[int64]types.PortableTypeV14
+	// Hashes of types which don't contain a recursive reference, by type id
+	cache map[int64][]byte
+	// The types being hashed, to stop recursion
+	visiting map[int64]bool
+}
+
+func newHasher(meta *types.MetadataV14) *hasher {
+	mtypes := map[int64]types.PortableTypeV14{}
+	for _, tdef := range meta.Lookup.Types {
+		mtypes[tdef.ID.Int64()] = tdef
+	}
+	return &hasher{types: mtypes, cache: map[int64][]byte{}, visiting: map[int64]bool{}}
+}
+
+// Hash each variant of a pallet's call or event type, along with the pallet index, as both are
+// part of the encoding
+func (h *hasher) variantHashes(res map[string]string, palletIndex uint8, typeId int64) error {
+	mt, ok := h.types[typeId]
+	if !ok {
+		return fmt.Errorf("type id=%v not found", typeId)
+	}
+	if !mt.Type.Def.IsVariant {
+		return nil
+	}
+	for _, v := range mt.Type.Def.Variant.Variants {
+		d := newDigest()
+		d.Write([]byte{palletIndex})
+		if err := h.writeVariant(d, &v); err != nil {
+			return err
+		}
+		res[string(v.Name)] = hexSum(d)
+	}
+	return nil
+}
+
+// Hash a storage entry: its prefix, name, modifier, hashers and types
+func (h *hasher) storageHash(prefix string, item *types.StorageEntryMetadataV14) (string, error) {
+	d := newDigest()
+	writeString(d, prefix)
+	writeString(d, string(item.Name))
+	d.Write([]byte{boolByte(item.Modifier.IsOptional), boolByte(item.Modifier.IsDefault), boolByte(item.Modifier.IsRequired)})
+	if item.Type.IsPlainType {
+		d.Write([]byte{0})
+		th, _, err := h.typeHash(item.Type.AsPlainType.Int64())
+		if err != nil {
+			return "", err
+		}
+		d.Write(th)
+	} else {
+		d.Write([]byte{1})
+		for _, hs := range item.Type.AsMap.Hashers {
+			d.Write([]byte{hasherByte(hs)})
+		}
+		for _, id := range []types.Si1LookupTypeID{item.Type.AsMap.Key, item.Type.AsMap.Value} {
+			th, _, err := h.typeHash(id.Int64())
+			if err != nil {
+				return "", err
+			}
+			d.Write(th)
+		}
+	}
+	return hexSum(d), nil
+}
+
+// Hash a type by its structure. Returns whether the hash includes a recursive reference, in which
+// case it depends on where the hashing started, so it isn't cached
+func (h *hasher) typeHash(typeId int64) ([]byte, bool, error) {
+	if th, ok := h.cache[typeId]; ok {
+		return th, false, nil
+	}
+	if h.visiting[typeId] {
+		d := newDigest()
+		writeString(d, "recursive")
+		return d.Sum(nil), true, nil
+	}
+	mt, ok := h.types[typeId]
+	if !ok {
+		return nil, false, fmt.Errorf("type id=%v not found", typeId)
+	}
+	h.visiting[typeId] = true
+	defer delete(h.visiting, typeId)
+
+	recursive := false
+	d := newDigest()
+	// Hash a contained type into the digest
+	inner := func(id types.Si1LookupTypeID) error {
+		th, rec, err := h.typeHash(id.Int64())
+		if err != nil {
+			return err
+		}
+		recursive = recursive || rec
+		d.Write(th)
+		return nil
+	}
+
+	tdef := mt.Type.Def
+	var err error
+	switch {
+	case tdef.IsComposite:
+		d.Write([]byte{0})
+		err = h.writeFields(d, tdef.Composite.Fields, inner)
+	case tdef.IsVariant:
+		d.Write([]byte{1})
+		for _, v := range tdef.Variant.Variants {
+			d.Write([]byte{byte(v.Index)})
+			writeString(d, string(v.Name))
+			if err = h.writeFields(d, v.Fields, inner); err != nil {
+				break
+			}
+		}
+	case tdef.IsSequence:
+		d.Write([]byte{2})
+		err = inner(tdef.Sequence.Type)
+	case tdef.IsArray:
+		d.Write([]byte{3})
+		writeUint(d, uint64(tdef.Array.Len))
+		err = inner(tdef.Array.Type)
+	case tdef.IsTuple:
+		d.Write([]byte{4})
+		writeUint(d, uint64(len(tdef.Tuple)))
+		for _, id := range tdef.Tuple {
+			if err = inner(id); err != nil {
+				break
+			}
+		}
+	case tdef.IsPrimitive:
+		d.Write([]byte{5, byte(tdef.Primitive.Si0TypeDefPrimitive)})
+	case tdef.IsCompact:
+		d.Write([]byte{6})
+		err = inner(tdef.Compact.Type)
+	case tdef.IsBitSequence:
+		d.Write([]byte{7})
+		if err = inner(tdef.BitSequence.BitStoreType); err == nil {
+			err = inner(tdef.BitSequence.BitOrderType)
+		}
+	default:
+		err = fmt.Errorf("type id=%v has an unknown definition", typeId)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	th := d.Sum(nil)
+	if !recursive {
+		h.cache[typeId] = th
+	}
+	return th, recursive, nil
+}
+
+// Write the hash of a variant's index, name and fields
+func (h *hasher) writeVariant(d hash.Hash, v *types.Si1Variant) error {
+	d.Write([]byte{byte(v.Index)})
+	writeString(d, string(v.Name))
+	return h.writeFields(d, v.Fields, func(id types.Si1LookupTypeID) error {
+		th, _, err := h.typeHash(id.Int64())
+		if err != nil {
+			return err
+		}
+		d.Write(th)
+		return nil
+	})
+}
+
+// Write the names of fields, and their types using `inner`
+func (h *hasher) writeFields(d hash.Hash, fields []types.Si1Field, inner func(types.Si1LookupTypeID) error) error {
+	writeUint(d, uint64(len(fields)))
+	for _, f := range fields {
+		writeString(d, string(f.Name))
+		if err := inner(f.Type); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newDigest() hash.Hash {
+	// Only errors with keys longer than 64 bytes
+	d, _ := gsrpchash.NewBlake2b256(nil)
+	return d
+}
+
+func hexSum(d hash.Hash) string {
+	return codec.HexEncodeToString(d.Sum(nil))
+}
+
+func writeString(d hash.Hash, s string) {
+	writeUint(d, uint64(len(s)))
+	d.Write([]byte(s))
+}
+
+func writeUint(d hash.Hash, v uint64) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	d.Write(b[:])
+}
+
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func hasherByte(h types.StorageHasherV10) byte {
+	switch {
+	case h.IsBlake2_128:
+		return 0
+	case h.IsBlake2_256:
+		return 1
+	case h.IsBlake2_128Concat:
+		return 2
+	case h.IsTwox128:
+		return 3
+	case h.IsTwox256:
+		return 4
+	case h.IsTwox64Concat:
+		return 5
+	}
+	return 6
+}
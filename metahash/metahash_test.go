@@ -0,0 +1,91 @@
+package metahash
+
+import (
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/internal/testmeta"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHash(t *testing.T) {
+	generated, err := Hash(testmeta.Example(t))
+	require.NoError(t, err)
+	require.NotEmpty(t, generated["System"].Calls["remark"])
+	require.NotEmpty(t, generated["System"].Storage["Account"])
+	require.NotEmpty(t, generated["System"].Events["ExtrinsicSuccess"])
+
+	// Renumbering the type ids keeps the structure, so every hash stays the same
+	live := testmeta.Example(t)
+	renumber(live, 1000)
+	renumbered, err := Hash(live)
+	require.NoError(t, err)
+	require.Equal(t, generated, renumbered)
+
+	// Renaming a field of System.remark only changes the hashes of the calls which contain it
+	live = testmeta.Example(t)
+	for i, ty := range live.Lookup.Types {
+		if len(ty.Type.Path) > 0 && ty.Type.Path[0] == "frame_system" && ty.Type.Def.IsVariant && ty.Type.Path[len(ty.Type.Path)-1] == "Call" {
+			for j, v := range ty.Type.Def.Variant.Variants {
+				if v.Name == "remark" {
+					live.Lookup.Types[i].Type.Def.Variant.Variants[j].Fields[0].Name = "note"
+				}
+			}
+		}
+	}
+	renamed, err := Hash(live)
+	require.NoError(t, err)
+	require.NotEqual(t, generated["System"].Calls["remark"], renamed["System"].Calls["remark"])
+	require.Equal(t, generated["System"].Calls["set_heap_pages"], renamed["System"].Calls["set_heap_pages"])
+	require.Equal(t, generated["System"].Storage, renamed["System"].Storage)
+	require.Equal(t, generated["Balances"], renamed["Balances"])
+	// Calls which wrap any call contain System.remark too
+	require.NotEqual(t, generated["Sudo"].Calls["sudo"], renamed["Sudo"].Calls["sudo"])
+	require.Equal(t, generated["Sudo"].Storage["Key"], renamed["Sudo"].Storage["Key"])
+}
+
+// Add an offset to every type id in the metadata
+func renumber(meta *types.MetadataV14, offset int64) {
+	shift := func(id *types.Si1LookupTypeID) {
+		*id = types.NewSi1LookupTypeIDFromUInt(uint64(id.Int64() + offset))
+	}
+	for i := range meta.Lookup.Types {
+		ty := &meta.Lookup.Types[i]
+		ty.ID = types.NewSi1LookupTypeIDFromUInt(uint64(ty.ID.Int64() + offset))
+		def := &ty.Type.Def
+		for j := range ty.Type.Params {
+			shift(&ty.Type.Params[j].Type)
+		}
+		for j := range def.Composite.Fields {
+			shift(&def.Composite.Fields[j].Type)
+		}
+		for j := range def.Variant.Variants {
+			for k := range def.Variant.Variants[j].Fields {
+				shift(&def.Variant.Variants[j].Fields[k].Type)
+			}
+		}
+		shift(&def.Sequence.Type)
+		shift(&def.Array.Type)
+		for j := range def.Tuple {
+			shift(&def.Tuple[j])
+		}
+		shift(&def.Compact.Type)
+		shift(&def.BitSequence.BitStoreType)
+		shift(&def.BitSequence.BitOrderType)
+	}
+	for i := range meta.Pallets {
+		p := &meta.Pallets[i]
+		shift(&p.Calls.Type)
+		shift(&p.Events.Type)
+		shift(&p.Errors.Type)
+		for j := range p.Constants {
+			shift(&p.Constants[j].Type)
+		}
+		for j := range p.Storage.Items {
+			st := &p.Storage.Items[j].Type
+			shift(&st.AsPlainType)
+			shift(&st.AsMap.Key)
+			shift(&st.AsMap.Value)
+		}
+	}
+}
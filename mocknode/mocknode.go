@@ -0,0 +1,306 @@
+// Package mocknode is a mock Substrate node, serving the json-rpc methods used by
+// go-substrate-rpc-client and the generated code over HTTP and websockets, so services can be
+// tested end to end without a node.
+//
+// It serves:
+//
+//	state_getMetadata, state_getStorage, state_getKeysPaged, state_call
+//	chain_getBlockHash
+//	author_submitExtrinsic
+package mocknode
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	gethrpc "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/hash"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// Answers a `state_call` of a runtime API method, given its SCALE-encoded arguments
+type CallHandler func(data []byte) ([]byte, error)
+
+// An extrinsic submitted with `author_submitExtrinsic`
+type Submitted struct {
+	// The blake2-256 hash of the extrinsic, which is returned to the submitter
+	Hash types.Hash
+	// The SCALE-encoded extrinsic
+	Data []byte
+}
+
+// Decode the extrinsic, e.g. into the generated `types.Extrinsic`, whose Call is the generated
+// `RuntimeCall`
+func (s Submitted) Decode(into interface{}) error {
+	return codec.Decode(s.Data, into)
+}
+
+// A mock node. Storage is set into the latest state, and NewBlock snapshots it as the next block. It
+// starts with a genesis block of empty storage. Like the generated chaintest.State, changes since the
+// last block make the latest state a pending block after it, with the zero hash: it's the latest
+// block, and reads without a block hash see it. It is safe for concurrent use, and implements
+// http.Handler, upgrading websocket requests.
+type Node struct {
+	// Called with every submitted extrinsic, if set before serving
+	OnSubmit func(Submitted)
+
+	mu sync.Mutex
+	// The hex-encoded metadata
+	metaHex string
+	// Encoded values by the hex of their storage key
+	latest map[string][]byte
+	// Whether the latest state changed since the last block
+	pending bool
+	// Blocks by number
+	blocks    []block
+	calls     map[string]CallHandler
+	submitted []Submitted
+
+	server    *gethrpc.Server
+	websocket http.Handler
+}
+
+// The storage at a block
+type block struct {
+	hash   types.Hash
+	values map[string][]byte
+}
+
+// Create a node for a runtime's metadata
+func New(meta *types.MetadataV14) (*Node, error) {
+	metaHex, err := codec.EncodeToHex(types.Metadata{
+		MagicNumber:   types.MagicNumber,
+		Version:       14,
+		AsMetadataV14: *meta,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error encoding metadata: %v", err)
+	}
+
+	n := &Node{metaHex: metaHex, latest: map[string][]byte{}, calls: map[string]CallHandler{}}
+	n.NewBlock()
+	n.server = gethrpc.NewServer()
+	for name, service := range map[string]interface{}{
+		"state":  stateService{n},
+		"chain":  chainService{n},
+		"author": authorService{n},
+	} {
+		if err := n.server.RegisterName(name, service); err != nil {
+			return nil, fmt.Errorf("error registering %v methods: %v", name, err)
+		}
+	}
+	n.websocket = n.server.WebsocketHandler([]string{"*"})
+	return n, nil
+}
+
+func (n *Node) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		n.websocket.ServeHTTP(w, r)
+		return
+	}
+	n.server.ServeHTTP(w, r)
+}
+
+// Stop serving, closing every connection
+func (n *Node) Close() {
+	n.server.Stop()
+}
+
+// Set the SCALE encoding of a value at a storage key in the latest state. This implements the
+// generated `chaintest.Writer`, so the generated Set{Pallet}{Item} functions seed the node with typed
+// values.
+func (n *Node) SetStorage(key types.StorageKey, value interface{}) error {
+	data, err := codec.Encode(value)
+	if err != nil {
+		return err
+	}
+	n.SetStorageRaw(key, data)
+	return nil
+}
+
+// Set encoded data at a storage key in the latest state
+func (n *Node) SetStorageRaw(key types.StorageKey, data []byte) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.latest[key.Hex()] = append([]byte{}, data...)
+	n.pending = true
+}
+
+// Remove the value at a storage key from the latest state
+func (n *Node) DeleteStorage(key types.StorageKey) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.latest, key.Hex())
+	n.pending = true
+}
+
+// Snapshot the latest state as the next block, and return its hash. Block hashes are the blake2-256
+// hash of the SCALE-encoded block number.
+func (n *Node) NewBlock() types.Hash {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	values := make(map[string][]byte, len(n.latest))
+	// Values are copied when they're set and never modified, so they can be shared
+	for k, v := range n.latest {
+		values[k] = v
+	}
+	number := types.NewU32(uint32(len(n.blocks)))
+	enc, _ := codec.Encode(number)
+	n.blocks = append(n.blocks, block{hash: blake2_256(enc), values: values})
+	n.pending = false
+	return n.blocks[len(n.blocks)-1].hash
+}
+
+// Answer the `state_call`s of a runtime API method, such as "TransactionPaymentApi_query_info"
+func (n *Node) HandleCall(method string, handler CallHandler) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.calls[method] = handler
+}
+
+// Get the extrinsics submitted so far, in order
+func (n *Node) Submitted() []Submitted {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]Submitted{}, n.submitted...)
+}
+
+func blake2_256(data []byte) types.Hash {
+	h, _ := hash.NewBlake2b256(nil)
+	h.Write(data)
+	return types.NewHash(h.Sum(nil))
+}
+
+// Get the storage at a hex-encoded block hash, or the latest storage without one. The zero hash is
+// the pending block's, if there is one.
+func (n *Node) storageAt(blockHash *string) (map[string][]byte, error) {
+	if blockHash == nil {
+		return n.latest, nil
+	}
+	want, err := types.NewHashFromHexString(*blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if n.pending && want == (types.Hash{}) {
+		return n.latest, nil
+	}
+	for _, b := range n.blocks {
+		if b.hash == want {
+			return b.values, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown block %v", *blockHash)
+}
+
+// The `state_` methods
+type stateService struct{ n *Node }
+
+func (s stateService) GetMetadata(blockHash *string) (string, error) {
+	return s.n.metaHex, nil
+}
+
+func (s stateService) GetStorage(key string, blockHash *string) (*string, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+	values, err := s.n.storageAt(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	data, ok := values[strings.ToLower(key)]
+	if !ok {
+		return nil, nil
+	}
+	res := codec.HexEncodeToString(data)
+	return &res, nil
+}
+
+// Get up to `count` keys starting with `prefix`, in order, after `startKey` if given
+func (s stateService) GetKeysPaged(prefix string, count uint32, startKey *string, blockHash *string) ([]string, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+	values, err := s.n.storageAt(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	prefix = strings.ToLower(prefix)
+	keys := []string{}
+	for k := range values {
+		if strings.HasPrefix(k, prefix) && (startKey == nil || k > strings.ToLower(*startKey)) {
+			keys = append(keys, k)
+		}
+	}
+	// Keys have the same 0x prefix, so they sort like their bytes
+	sort.Strings(keys)
+	if len(keys) > int(count) {
+		keys = keys[:count]
+	}
+	return keys, nil
+}
+
+func (s stateService) Call(method string, data string, blockHash *string) (string, error) {
+	s.n.mu.Lock()
+	handler, ok := s.n.calls[method]
+	s.n.mu.Unlock()
+	if !ok {
+		return "", fmt.Errorf("no handler for runtime API method %v", method)
+	}
+	args, err := codec.HexDecodeString(data)
+	if err != nil {
+		return "", err
+	}
+	res, err := handler(args)
+	if err != nil {
+		return "", err
+	}
+	return codec.HexEncodeToString(res), nil
+}
+
+// The `chain_` methods
+type chainService struct{ n *Node }
+
+// Get the hash of a block, or of the latest block without a number. The pending block, numbered
+// after the last one, has the zero hash. Unknown blocks have no hash.
+func (c chainService) GetBlockHash(number *uint64) (*string, error) {
+	c.n.mu.Lock()
+	defer c.n.mu.Unlock()
+	blocks := uint64(len(c.n.blocks))
+	if c.n.pending {
+		blocks++
+	}
+	i := blocks - 1
+	if number != nil {
+		i = *number
+	}
+	if i >= blocks {
+		return nil, nil
+	}
+	if i == uint64(len(c.n.blocks)) {
+		res := types.Hash{}.Hex()
+		return &res, nil
+	}
+	res := c.n.blocks[i].hash.Hex()
+	return &res, nil
+}
+
+// The `author_` methods
+type authorService struct{ n *Node }
+
+// Record an extrinsic, and return its hash
+func (a authorService) SubmitExtrinsic(extrinsic string) (string, error) {
+	data, err := codec.HexDecodeString(extrinsic)
+	if err != nil {
+		return "", err
+	}
+	sub := Submitted{Hash: blake2_256(data), Data: data}
+	a.n.mu.Lock()
+	a.n.submitted = append(a.n.submitted, sub)
+	a.n.mu.Unlock()
+	if a.n.OnSubmit != nil {
+		a.n.OnSubmit(sub)
+	}
+	return sub.Hash.Hex(), nil
+}
@@ -0,0 +1,110 @@
+package mocknode
+
+import (
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/metadata"
+	gsrpc "github.com/centrifuge/go-substrate-rpc-client/v4"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode(t *testing.T) {
+	inp, err := os.ReadFile("../testdata/fixtures/minimal.json")
+	require.NoError(t, err)
+	meta, _, err := metadata.ParseMetadata(inp)
+	require.NoError(t, err)
+	node, err := New(meta)
+	require.NoError(t, err)
+	srv := httptest.NewServer(node)
+	defer srv.Close()
+	defer node.Close()
+
+	key, err := types.CreateStorageKey(&types.Metadata{Version: 14, AsMetadataV14: *meta}, "System", "BlockHash", []byte{1, 0, 0, 0})
+	require.NoError(t, err)
+	require.NoError(t, node.SetStorage(key, types.Hash{1}))
+	block1 := node.NewBlock()
+	require.NoError(t, node.SetStorage(key, types.Hash{2}))
+	node.HandleCall("Core_version", func(data []byte) ([]byte, error) {
+		return append(data, 9), nil
+	})
+
+	for _, url := range []string{srv.URL, "ws" + strings.TrimPrefix(srv.URL, "http")} {
+		t.Run(strings.SplitN(url, ":", 2)[0], func(t *testing.T) {
+			api, err := gsrpc.NewSubstrateAPI(url)
+			require.NoError(t, err)
+			got, err := api.RPC.State.GetMetadataLatest()
+			require.NoError(t, err)
+			require.Equal(t, len(meta.Pallets), len(got.AsMetadataV14.Pallets))
+
+			hash, err := api.RPC.Chain.GetBlockHash(1)
+			require.NoError(t, err)
+			require.Equal(t, block1, hash)
+			// The change after block 1 is a pending block with the zero hash
+			latest, err := api.RPC.Chain.GetBlockHashLatest()
+			require.NoError(t, err)
+			require.Equal(t, types.Hash{}, latest)
+			hash, err = api.RPC.Chain.GetBlockHash(2)
+			require.NoError(t, err)
+			require.Equal(t, types.Hash{}, hash)
+
+			var value types.Hash
+			ok, err := api.RPC.State.GetStorage(key, &value, block1)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, types.Hash{1}, value)
+			ok, err = api.RPC.State.GetStorageLatest(key, &value)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, types.Hash{2}, value)
+			ok, err = api.RPC.State.GetStorage(key, &value, latest)
+			require.NoError(t, err)
+			require.True(t, ok)
+			require.Equal(t, types.Hash{2}, value)
+			ok, err = api.RPC.State.GetStorageLatest(types.StorageKey{1}, &value)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			var keys []string
+			require.NoError(t, api.Client.Call(&keys, "state_getKeysPaged", key.Hex()[:34], 10))
+			require.Equal(t, []string{key.Hex()}, keys)
+			require.NoError(t, api.Client.Call(&keys, "state_getKeysPaged", key.Hex()[:34], 10, key.Hex()))
+			require.Empty(t, keys)
+
+			var res string
+			require.NoError(t, api.Client.Call(&res, "state_call", "Core_version", "0x01"))
+			require.Equal(t, "0x0109", res)
+			require.Error(t, api.Client.Call(&res, "state_call", "Core_missing", "0x"))
+		})
+	}
+
+	api, err := gsrpc.NewSubstrateAPI(srv.URL)
+	require.NoError(t, err)
+
+	// A new block of the pending changes is the latest block again
+	block2 := node.NewBlock()
+	latest, err := api.RPC.Chain.GetBlockHashLatest()
+	require.NoError(t, err)
+	require.Equal(t, block2, latest)
+	_, err = api.RPC.Chain.GetBlockHash(3)
+	require.Error(t, err)
+	var value types.Hash
+	_, err = api.RPC.State.GetStorage(key, &value, types.Hash{})
+	require.Error(t, err)
+
+	// Submitted extrinsics are recorded, and decode like the extrinsics of a block
+	ext, err := codec.EncodeToHex([]byte{4, 0, 0})
+	require.NoError(t, err)
+	var hash types.Hash
+	require.NoError(t, api.Client.Call(&hash, "author_submitExtrinsic", ext))
+	submitted := node.Submitted()
+	require.Len(t, submitted, 1)
+	require.Equal(t, hash, submitted[0].Hash)
+	var inner []byte
+	require.NoError(t, submitted[0].Decode(&inner))
+	require.Equal(t, []byte{4, 0, 0}, inner)
+}
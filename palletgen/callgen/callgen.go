@@ -10,7 +10,7 @@ import (
 )
 
 // The call generator generates one method per available extrinsic in the pallet which calls the
-// corresponding extrinsic.
+// corresponding extrinsic, and a params struct per extrinsic to build the call from named fields.
 type CallGenerator struct {
 	F      *jen.File
 	pallet *types.PalletMetadataV14
@@ -23,7 +23,8 @@ func NewCallGenerator(pkgPath string, pallet *types.PalletMetadataV14, tygen *ty
 }
 
 // Generate all extrinsic calls for a particular pallet.
-// Each is of the form Make{PalletExtrinsicName}Call
+// Each is of the form Make{PalletExtrinsicName}Call, with a {PalletExtrinsicName}Params struct
+// which builds the same call from named fields
 func (cg *CallGenerator) Generate() error {
 	// Get the base call type for the calls in this pallet
 	// Example name:
@@ -35,8 +36,7 @@ func (cg *CallGenerator) Generate() error {
 	// Cast the base call type as a variant, which it should always be
 	gend, ok := baseGend.(*typegen.VariantGend)
 	if !ok {
-		fmt.Printf("Warning: Call type %v for pallet %v is not a variant\n",
-			cg.pallet.Calls.Type.Int64(), cg.pallet.Name)
+		cg.tygen.Warn("Call type %v for pallet %v is not a variant", cg.pallet.Calls.Type.Int64(), cg.pallet.Name)
 		return nil
 	}
 
@@ -66,9 +66,14 @@ func (cg *CallGenerator) Generate() error {
 	// Each variant of our pallet call type corresponds to a particular extrinsic of our pallet, so
 	// we generate a call for each
 	for _, variant := range tdvariant.Variants {
-		cg.generateCall(variant, gend, rtc, rtcIsVarField.Name, rtcAsVarField)
+		if err := cg.generateCall(variant, gend, rtc, rtcIsVarField.Name, rtcAsVarField); err != nil {
+			return err
+		}
+		if err := cg.generateParams(variant, gend, rtc, rtcIsVarField.Name, rtcAsVarField); err != nil {
+			return err
+		}
 	}
-	return nil
+	return cg.generateWrappers(rtc)
 }
 
 // Generate a function to call a particular pallet extrinsic.
@@ -91,7 +96,8 @@ func (cg *CallGenerator) generateCall(variant types.Si1Variant, gend, rtc *typeg
 	// Get all the arguments to our method
 	funcName := utils.AsName("Make", string(variant.Name), "Call")
 	funcArgs := []jen.Code{}
-	funcArgNames := []string{}
+	// The value of each field, built from its arguments
+	fieldValues := []jen.Code{}
 	var callInd uint32
 	// The fields on the variants are the arguments to the extrinsic, so we get each
 	for _, field := range variant.Fields {
@@ -104,7 +110,11 @@ func (cg *CallGenerator) generateCall(variant types.Si1Variant, gend, rtc *typeg
 			return err
 		}
 		funcArgs = append(funcArgs, fieldArgs...)
-		funcArgNames = append(funcArgNames, fieldArgNames...)
+		value, err := cg.tygen.GenerateArgsValue(fGend, fieldArgNames)
+		if err != nil {
+			return err
+		}
+		fieldValues = append(fieldValues, value)
 	}
 
 	// Index of this call variant in the pallet call gend
@@ -129,9 +139,9 @@ func (cg *CallGenerator) generateCall(variant types.Si1Variant, gend, rtc *typeg
 					g4.Id(gend.IsVarFields[gendInd].Name).Op(":").Lit(true).Op(",")
 					for i, fld := range gend.AsVarFields[gendInd] {
 						if fld.IsPtr {
-							g4.Id(fld.Name).Op(": &").Id(funcArgNames[i]).Op(",")
+							g4.Id(fld.Name).Op(": &").Add(fieldValues[i]).Op(",")
 						} else {
-							g4.Id(fld.Name).Op(":").Id(funcArgNames[i]).Op(",")
+							g4.Id(fld.Name).Op(":").Add(fieldValues[i]).Op(",")
 						}
 					}
 				}).Op(",")
@@ -141,3 +151,108 @@ func (cg *CallGenerator) generateCall(variant types.Si1Variant, gend, rtc *typeg
 
 	return nil
 }
+
+// Generate a struct holding the named parameters of a pallet extrinsic, with a method to build the
+// call from it. Unlike the Make...Call functions, the fields keep the names and types of the call's
+// fields in the metadata, so tuples aren't flattened and no numeric suffixes are added. Pointer
+// fields are required, and are checked by Validate.
+// example output (docs omitted):
+//
+//	type SetKeyParams struct {
+//		New types.MultiAddress
+//	}
+//
+//	func (p SetKeyParams) Validate() error {
+//		return nil
+//	}
+//
+//	func (p SetKeyParams) Build() (ret types.RuntimeCall, err error) {
+//		err = p.Validate()
+//		if err != nil {
+//			return
+//		}
+//		ret = types.RuntimeCall{
+//			IsSudo: true,
+//			AsSudoField0: types.PalletSudoPalletCall{
+//				IsSetKey:     true,
+//				AsSetKeyNew0: p.New,
+//			},
+//		}
+//		return
+//	}
+func (cg *CallGenerator) generateParams(variant types.Si1Variant, gend, rtc *typegen.VariantGend, rtcIsVarName string, rtcAsVarField typegen.GenField) error {
+	structName := utils.AsName(string(variant.Name), "Params")
+
+	// Index of this call variant in the pallet call gend
+	gendInd, err := gend.IndOf(uint8(variant.Index))
+	if err != nil {
+		return err
+	}
+	asFields := gend.AsVarFields[gendInd]
+
+	// Each field of the call variant is a field of the params struct
+	fields := []jen.Code{}
+	fieldNames := []string{}
+	for i, field := range variant.Fields {
+		fGend, err := cg.tygen.GetType(field.Type.Int64())
+		if err != nil {
+			return err
+		}
+		name := string(field.Name)
+		if name == "" {
+			name = fmt.Sprint("Field", i)
+		}
+		name = utils.AsName(name)
+		fieldNames = append(fieldNames, name)
+
+		for _, d := range field.Docs {
+			fields = append(fields, jen.Comment(string(d)))
+		}
+		if asFields[i].IsPtr {
+			fields = append(fields, jen.Id(name).Op("*").Custom(utils.TypeOpts, fGend.Code()))
+		} else {
+			fields = append(fields, jen.Id(name).Custom(utils.TypeOpts, fGend.Code()))
+		}
+	}
+
+	cg.F.Comment(fmt.Sprintf("Named parameters of the %v call. Use Build to make the call", variant.Name))
+	cg.F.Type().Id(structName).Struct(fields...)
+
+	cg.F.Comment("Check that every required (pointer) field is set")
+	cg.F.Func().Params(jen.Id("p").Id(structName)).Id("Validate").Params().Error().BlockFunc(func(g *jen.Group) {
+		for i, name := range fieldNames {
+			if !asFields[i].IsPtr {
+				continue
+			}
+			g.If(jen.Id("p").Dot(name).Op("==").Nil()).Block(
+				jen.Return(jen.Qual("errors", "New").Call(jen.Lit(fmt.Sprintf("%v.%v is required", structName, name)))),
+			)
+		}
+		g.Return(jen.Nil())
+	})
+
+	cg.F.Comment("Validate the params and make the call")
+	cg.F.Func().Params(jen.Id("p").Id(structName)).Id("Build").Params().Params(
+		jen.Id("ret").Custom(utils.TypeOpts, rtc.Code()), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		g.Err().Op("=").Id("p").Dot("Validate").Call()
+		utils.ErrorCheckWithNamedArgs(g)
+		g.Id("ret").Op("=").Custom(utils.TypeOpts, rtc.Code()).ValuesFunc(func(g1 *jen.Group) {
+			g1.Line().Id(rtcIsVarName).Op(":").True()
+			pre := g1.Line().Id(rtcAsVarField.Name).Op(":")
+			if rtcAsVarField.IsPtr {
+				pre.Op("&")
+			}
+			pre.Custom(utils.TypeOpts, gend.Code()).ValuesFunc(func(g2 *jen.Group) {
+				g2.Line().Id(gend.IsVarFields[gendInd].Name).Op(":").True()
+				for i, fld := range asFields {
+					g2.Line().Id(fld.Name).Op(":").Id("p").Dot(fieldNames[i])
+				}
+				g2.Line()
+			})
+			g1.Line()
+		})
+		g.Return()
+	})
+	return nil
+}
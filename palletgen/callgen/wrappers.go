@@ -0,0 +1,213 @@
+package callgen
+
+import (
+	"fmt"
+
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/dave/jennifer/jen"
+)
+
+// The names of the helpers generated for calls which wrap other calls, by pallet name then call name
+var wrapperHelpers = map[string]map[string]string{
+	"Utility": {
+		"batch":         "Batch",
+		"batch_all":     "BatchAll",
+		"force_batch":   "ForceBatch",
+		"as_derivative": "AsDerivative",
+	},
+	"Sudo": {
+		"sudo":                  "AsSudo",
+		"sudo_unchecked_weight": "AsSudoUncheckedWeight",
+		"sudo_as":               "SudoAs",
+	},
+	"Proxy": {
+		"proxy":           "AsProxy",
+		"proxy_announced": "AsProxyAnnounced",
+	},
+	"Multisig": {
+		"as_multi":             "AsMulti",
+		"as_multi_threshold_1": "AsMultiThreshold1",
+	},
+}
+
+// How a call argument holds the runtime calls it wraps
+type wrappedKind int
+
+const (
+	notWrapped wrappedKind = iota
+	// A RuntimeCall, or a Box<RuntimeCall>
+	wrappedCall
+	// A Vec<RuntimeCall>
+	wrappedCalls
+	// A WrapperKeepOpaque<RuntimeCall>, which holds the length of the encoded call before the call
+	wrappedOpaque
+)
+
+// Generate helpers for the pallet's calls which wrap other calls, if it's one of the pallets in
+// wrapperHelpers, and a call hash function for the multisig pallet.
+func (cg *CallGenerator) generateWrappers(rtc *typegen.VariantGend) error {
+	helpers, ok := wrapperHelpers[string(cg.pallet.Name)]
+	if !ok {
+		return nil
+	}
+
+	baseGend, err := cg.tygen.GetType(cg.pallet.Calls.Type.Int64())
+	if err != nil {
+		return err
+	}
+	for _, variant := range baseGend.MType().Type.Def.Variant.Variants {
+		helperName, ok := helpers[string(variant.Name)]
+		if !ok {
+			continue
+		}
+		if err := cg.generateWrapper(helperName, variant, rtc); err != nil {
+			return err
+		}
+	}
+
+	if cg.pallet.Name == "Multisig" {
+		cg.generateCallHash(rtc)
+	}
+	return nil
+}
+
+// Generate a helper which makes a call wrapping other calls, taking the wrapped calls as
+// RuntimeCall values. A trailing Vec<RuntimeCall> argument becomes variadic. The helper returns an
+// error if it has to encode a wrapped call.
+// example output (docs omitted):
+//
+//	func Batch(calls0 ...types.RuntimeCall) types.RuntimeCall {
+//		return MakeBatchCall(calls0)
+//	}
+//
+//	func AsMulti(threshold0 uint16, ..., call3 types.RuntimeCall, ...) (ret types.RuntimeCall, err error) {
+//		call3Encoded, err := codec.Encode(call3)
+//		if err != nil {
+//			return
+//		}
+//		ret = MakeAsMultiCall(threshold0, ..., types.WrapperKeepOpaque{
+//			Field:  types1.NewUCompactFromUInt(uint64(len(call3Encoded))),
+//			Field1: call3,
+//		}, ...)
+//		return
+//	}
+func (cg *CallGenerator) generateWrapper(helperName string, variant types.Si1Variant, rtc *typegen.VariantGend) error {
+	callId := rtc.MType().ID.Int64()
+
+	// The helper's arguments are those of the Make...Call function, with the wrapped calls replaced
+	helperArgs := []jen.Code{}
+	// The values passed to the Make...Call function
+	makeArgs := []jen.Code{}
+	// The names of the wrapped calls which must be encoded
+	encoded := []string{}
+	hasWrapped := false
+	var callInd uint32
+	for i, field := range variant.Fields {
+		fGend, err := cg.tygen.GetType(field.Type.Int64())
+		if err != nil {
+			return err
+		}
+		kind := wrappedKindOf(fGend, callId)
+		if kind == notWrapped {
+			args, names, err := cg.tygen.GenerateArgs(fGend, &callInd, string(field.Name))
+			if err != nil {
+				return err
+			}
+			helperArgs = append(helperArgs, args...)
+			for _, n := range names {
+				makeArgs = append(makeArgs, jen.Id(n))
+			}
+			continue
+		}
+
+		hasWrapped = true
+		name := utils.AsArgName(string(field.Name), fmt.Sprint(callInd))
+		callInd += 1
+		switch kind {
+		case wrappedCall:
+			helperArgs = append(helperArgs, jen.Id(name).Custom(utils.TypeOpts, rtc.Code()))
+			makeArgs = append(makeArgs, jen.Id(name))
+		case wrappedCalls:
+			if i == len(variant.Fields)-1 {
+				helperArgs = append(helperArgs, jen.Id(name).Op("...").Custom(utils.TypeOpts, rtc.Code()))
+			} else {
+				helperArgs = append(helperArgs, jen.Id(name).Index().Custom(utils.TypeOpts, rtc.Code()))
+			}
+			makeArgs = append(makeArgs, jen.Id(name))
+		case wrappedOpaque:
+			opaque := fGend.(*typegen.CompositeGend)
+			helperArgs = append(helperArgs, jen.Id(name).Custom(utils.TypeOpts, rtc.Code()))
+			callValue := jen.Id(name)
+			if opaque.Fields[1].IsPtr {
+				callValue = jen.Op("&").Id(name)
+			}
+			makeArgs = append(makeArgs, jen.Custom(utils.TypeOpts, opaque.Code()).Values(jen.Dict{
+				jen.Id(opaque.Fields[0].Name): jen.Qual(utils.CTYPES, "NewUCompactFromUInt").Call(
+					jen.Uint64().Call(jen.Len(jen.Id(name + "Encoded"))),
+				),
+				jen.Id(opaque.Fields[1].Name): callValue,
+			}))
+			encoded = append(encoded, name)
+		}
+	}
+	if !hasWrapped {
+		return nil
+	}
+
+	makeName := utils.AsName("Make", string(variant.Name), "Call")
+	cg.F.Comment(fmt.Sprintf("Make a %v call wrapping the given calls. See %v", variant.Name, makeName))
+	if len(encoded) == 0 {
+		cg.F.Func().Id(helperName).Params(helperArgs...).Custom(utils.TypeOpts, rtc.Code()).Block(
+			jen.Return(jen.Id(makeName).Call(makeArgs...)),
+		)
+		return nil
+	}
+	cg.F.Func().Id(helperName).Params(helperArgs...).Params(
+		jen.Id("ret").Custom(utils.TypeOpts, rtc.Code()), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		for _, name := range encoded {
+			g.List(jen.Id(name+"Encoded"), jen.Err()).Op(":=").Qual(utils.CCODEC, "Encode").Call(jen.Id(name))
+			utils.ErrorCheckWithNamedArgs(g)
+		}
+		g.Id("ret").Op("=").Id(makeName).Call(makeArgs...)
+		g.Return()
+	})
+	return nil
+}
+
+// Get how a call argument of the given type holds runtime calls
+func wrappedKindOf(gend typegen.GeneratedType, callId int64) wrappedKind {
+	mt := gend.MType()
+	if mt.ID.Int64() == callId {
+		return wrappedCall
+	}
+	tdef := mt.Type.Def
+	if tdef.IsSequence && tdef.Sequence.Type.Int64() == callId {
+		return wrappedCalls
+	}
+	path := mt.Type.Path
+	if cgend, ok := gend.(*typegen.CompositeGend); ok && len(path) > 0 && path[len(path)-1] == "WrapperKeepOpaque" &&
+		len(tdef.Composite.Fields) == 2 && tdef.Composite.Fields[1].Type.Int64() == callId && len(cgend.Fields) == 2 {
+		return wrappedOpaque
+	}
+	return notWrapped
+}
+
+// Generate a function which computes the hash of a call, as used by the multisig pallet to
+// identify calls: the blake2-256 hash of the call data.
+// example output:
+//
+//	func CallHash(call types.RuntimeCall) ([32]byte, error) {
+//		return call.CallHash()
+//	}
+func (cg *CallGenerator) generateCallHash(rtc *typegen.VariantGend) {
+	cg.F.Comment("Get the hash of a call, which the multisig pallet uses to identify calls: the blake2-256 hash of")
+	cg.F.Comment("the call data")
+	cg.F.Func().Id("CallHash").Params(jen.Id("call").Custom(utils.TypeOpts, rtc.Code())).Params(
+		jen.Index(jen.Lit(32)).Byte(), jen.Error(),
+	).Block(
+		jen.Return(jen.Id("call").Dot("CallHash").Call()),
+	)
+}
@@ -11,10 +11,11 @@ import (
 )
 
 // TODO: better method for testing
-func noTestGenBigMetadata(t *testing.T) {
-	inp, err := ioutil.ReadFile("../polkadot-meta.json")
+func TestGenBigMetadata(t *testing.T) {
+	inp, err := ioutil.ReadFile("../testdata/fixtures/kinds.json")
 	require.NoError(t, err)
 	mr, encMeta, err := metadata.ParseMetadata(inp)
+	require.NoError(t, err)
 
 	for _, pal := range mr.Pallets {
 		tg := typegen.NewTypeGenerator(mr, encMeta, "github.com/aphoh/go-substrate-gen/palletgen")
@@ -34,6 +35,31 @@ func noTestGenBigMetadata(t *testing.T) {
 	}
 }
 
+// A call's tuple arguments are taken flattened, and nested back into the tuple
+func TestTupleArgs(t *testing.T) {
+	inp, err := ioutil.ReadFile("../testdata/fixtures/kinds.json")
+	require.NoError(t, err)
+	mr, encMeta, err := metadata.ParseMetadata(inp)
+	require.NoError(t, err)
+
+	tg := typegen.NewTypeGenerator(mr, encMeta, "example.com/kinds/types")
+	found := false
+	for _, pal := range mr.Pallets {
+		if pal.Name != "Kinds" {
+			continue
+		}
+		found = true
+		palletGen := NewPalletGenerator(&pal, &tg)
+		calls, _, err := palletGen.GenerateCalls("example.com/kinds/kinds")
+		require.NoError(t, err)
+		// all_kinds takes pair: (u32, u64) and triple: (u8, u16, u32)
+		require.Contains(t, calls, "pair5 uint32, pair6 uint64, triple7 byte, triple8 uint16, triple9 uint32")
+		require.Regexp(t, `AsAllKindsPair5: types\.TupleOfUint32Uint64\{\s*Elem0: pair5,\s*Elem1: pair6,\s*\}`, calls)
+		require.Regexp(t, `AsAllKindsTriple6: types\.\w+\{\s*Elem0: triple7,\s*Elem1: triple8,\s*Elem2: triple9,\s*\}`, calls)
+	}
+	require.True(t, found)
+}
+
 // Enable this to see some sample output
 func noTestSamplePalletOutput(t *testing.T) {
 	inp, err := ioutil.ReadFile("../polkadot-meta.json")
@@ -15,6 +15,9 @@ import (
 // - a method to make a storage key (this is public, but not necessary to use)
 // - a method to access the storage at a specific block hash
 // - a method to access the current storage state
+// - for maps, methods to access many keys at once, at a specific block hash or at the current state
+// - a method to subscribe to changes of the storage item
+// - methods to query the changes of the storage item over a range of blocks
 type StorageGenerator struct {
 	F       *jen.File
 	storage *types.StorageMetadataV14
@@ -71,6 +74,10 @@ func (sg *StorageGenerator) GenPlain(v types.Si1LookupTypeID, item *types.Storag
 	// Note that the getters need no real arguments, because this is not a map
 	sg.generateGetter(true, methodName, []jen.Code{}, []string{}, retGend, item)
 	sg.generateGetter(false, methodName, []jen.Code{}, []string{}, retGend, item)
+	sg.generateChangeSet(nil, retGend, item)
+	sg.generateSubscription(methodName, nil, nil, item)
+	sg.generateRangeQuery(true, methodName, nil, nil, item)
+	sg.generateRangeQuery(false, methodName, nil, nil, item)
 	return nil
 }
 
@@ -140,6 +147,19 @@ func (sg *StorageGenerator) GenMap(p types.MapTypeV14, item *types.StorageEntryM
 	// Note that the getter functions here *do* need the arguments provided because the storage item is a map
 	sg.generateGetter(true, methodName, args, keyArgNames, retGend, item)
 	sg.generateGetter(false, methodName, args, keyArgNames, retGend, item)
+
+	// The multi getters take a slice of keys, each of which is split back into the arguments of
+	// the Make{..}StorageKey method
+	keyAccessors, err := sg.tygen.GenerateArgAccessors(gend, "k")
+	if err != nil {
+		return err
+	}
+	sg.generateMultiGetter(true, methodName, gend, keyAccessors, retGend, item)
+	sg.generateMultiGetter(false, methodName, gend, keyAccessors, retGend, item)
+	sg.generateChangeSet(gend, retGend, item)
+	sg.generateSubscription(methodName, gend, keyAccessors, item)
+	sg.generateRangeQuery(true, methodName, gend, keyAccessors, item)
+	sg.generateRangeQuery(false, methodName, gend, keyAccessors, item)
 	return nil
 }
 
@@ -147,7 +167,7 @@ func (sg *StorageGenerator) GenMap(p types.MapTypeV14, item *types.StorageEntryM
 func (sg *StorageGenerator) generateGetter(withBlockhash bool, sKeyMethod string, sKeyArgs []jen.Code, sKeyArgNames []string, returnType typegen.GeneratedType, item *types.StorageEntryMetadataV14) error {
 
 	// Add state and (maybe) blockhash to the method arguments
-	args := []jen.Code{jen.Id("state").Qual(utils.GSRPCState, "State")}
+	args := sg.backendArgs(typegen.StorageReaderName)
 	if withBlockhash {
 		args = append(args, jen.Id("bhash").Qual(utils.CTYPES, "Hash"))
 	}
@@ -199,9 +219,9 @@ func (sg *StorageGenerator) generateGetter(withBlockhash bool, sKeyMethod string
 
 		// Make the actual storage call
 		if withBlockhash {
-			g.List(jen.Id("isSome"), jen.Err()).Op("=").Id("state").Dot("GetStorage").Call(jen.Id("key"), jen.Op("&").Id("ret"), jen.Id("bhash"))
+			g.List(jen.Id("isSome"), jen.Err()).Op("=").Id("state").Dot("GetStorage").Call(sg.ctxArg(jen.Id("key"), jen.Op("&").Id("ret"), jen.Id("bhash"))...)
 		} else {
-			g.List(jen.Id("isSome"), jen.Err()).Op("=").Id("state").Dot("GetStorageLatest").Call(jen.Id("key"), jen.Op("&").Id("ret"))
+			g.List(jen.Id("isSome"), jen.Err()).Op("=").Id("state").Dot("GetStorageLatest").Call(sg.ctxArg(jen.Id("key"), jen.Op("&").Id("ret"))...)
 		}
 		utils.ErrorCheckWithNamedArgs(g)
 
@@ -218,3 +238,473 @@ func (sg *StorageGenerator) generateGetter(withBlockhash bool, sKeyMethod string
 
 	return nil
 }
+
+// Generate a getter function which fetches the values of many keys of a storage map in a single
+// `state_queryStorageAt` call. If `withBlockHash`, add an argument to get them at a particular block
+// hash, otherwise name the function latest. Values are returned in the same order as the keys.
+//
+// example output:
+//
+//	func GetAccountMulti(state state.State, bhash types.Hash, keys [][32]byte) (ret []AccountInfo, err error) {
+//		skeys := make([]types.StorageKey, len(keys))
+//		indices := map[string][]int{}
+//		for i, k := range keys {
+//			skeys[i], err = MakeAccountStorageKey(k)
+//			if err != nil {
+//				return
+//			}
+//			indices[skeys[i].Hex()] = append(indices[skeys[i].Hex()], i)
+//		}
+//		sets, err := state.QueryStorageAt(skeys, bhash)
+//		if err != nil {
+//			return
+//		}
+//		ret = make([]AccountInfo, len(keys))
+//		isSome := make([]bool, len(keys))
+//		for _, set := range sets {
+//			for _, change := range set.Changes {
+//				for _, i := range indices[change.StorageKey.Hex()] {
+//					isSome[i] = change.HasStorageData
+//					if change.HasStorageData {
+//						err = codec.Decode(change.StorageData, &ret[i])
+//						if err != nil {
+//							return
+//						}
+//					}
+//				}
+//			}
+//		}
+//		for i := range ret {
+//			if !isSome[i] {
+//				err = codec.Decode(AccountResultDefaultBytes, &ret[i])
+//				if err != nil {
+//					return
+//				}
+//			}
+//		}
+//		return
+//	}
+func (sg *StorageGenerator) generateMultiGetter(withBlockhash bool, sKeyMethod string, keyType typegen.GeneratedType, keyAccessors []jen.Code, returnType typegen.GeneratedType, item *types.StorageEntryMetadataV14) {
+	args := sg.backendArgs(typegen.StorageQuerierName)
+	if withBlockhash {
+		args = append(args, jen.Id("bhash").Qual(utils.CTYPES, "Hash"))
+	}
+	args = append(args, jen.Id("keys").Index().Custom(utils.TypeOpts, keyType.Code()))
+
+	retArgs := []jen.Code{jen.Id("ret").Index().Custom(utils.TypeOpts, returnType.Code())}
+	if item.Modifier.IsOptional {
+		retArgs = append(retArgs, jen.Id("isSome").Index().Bool())
+	}
+	ret := jen.List(append(retArgs, jen.Err().Error())...)
+
+	var methodName string
+	if withBlockhash {
+		methodName = utils.AsName("Get", string(item.Name), "Multi")
+	} else {
+		methodName = utils.AsName("Get", string(item.Name), "Multi", "Latest")
+	}
+
+	sg.F.Func().Id(methodName).Call(args...).Call(ret).BlockFunc(func(g *jen.Group) {
+		genKeysWithIndices(g, sKeyMethod, keyAccessors)
+
+		// Make the actual storage call
+		if withBlockhash {
+			g.List(jen.Id("sets"), jen.Err()).Op(":=").Id("state").Dot("QueryStorageAt").Call(sg.ctxArg(jen.Id("skeys"), jen.Id("bhash"))...)
+		} else {
+			g.List(jen.Id("sets"), jen.Err()).Op(":=").Id("state").Dot("QueryStorageAtLatest").Call(sg.ctxArg(jen.Id("skeys"))...)
+		}
+		utils.ErrorCheckWithNamedArgs(g)
+
+		g.Id("ret").Op("=").Make(jen.Index().Custom(utils.TypeOpts, returnType.Code()), jen.Len(jen.Id("keys")))
+		if item.Modifier.IsOptional {
+			// if it's optional, this is defined in the return args
+			g.Id("isSome").Op("=").Make(jen.Index().Bool(), jen.Len(jen.Id("keys")))
+		} else {
+			g.Id("isSome").Op(":=").Make(jen.Index().Bool(), jen.Len(jen.Id("keys")))
+		}
+
+		// Decode each returned value into the position of every key it answers
+		g.For(jen.List(jen.Id("_"), jen.Id("set")).Op(":=").Range().Id("sets")).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("change")).Op(":=").Range().Id("set").Dot("Changes")).Block(
+				jen.For(jen.List(jen.Id("_"), jen.Id("i")).Op(":=").Range().Id("indices").Index(jen.Id("change").Dot("StorageKey").Dot("Hex").Call())).BlockFunc(func(g1 *jen.Group) {
+					g1.Id("isSome").Index(jen.Id("i")).Op("=").Id("change").Dot("HasStorageData")
+					g1.If(jen.Id("change").Dot("HasStorageData")).BlockFunc(func(g2 *jen.Group) {
+						g2.Err().Op("=").Qual(utils.CCODEC, "Decode").Call(jen.Id("change").Dot("StorageData"), jen.Op("&").Id("ret").Index(jen.Id("i")))
+						utils.ErrorCheckWithNamedArgs(g2)
+					})
+				}),
+			),
+		)
+
+		// If not optional, use the default for every missing value
+		if item.Modifier.IsDefault {
+			defaultBytesName := utils.AsName(string(item.Name), "ResultDefaultBytes")
+			g.For(jen.Id("i").Op(":=").Range().Id("ret")).Block(
+				jen.If(jen.Op("!").Id("isSome").Index(jen.Id("i"))).BlockFunc(func(g1 *jen.Group) {
+					g1.Err().Op("=").Qual(utils.CCODEC, "Decode").Call(jen.Id(defaultBytesName), jen.Op("&").Id("ret").Index(jen.Id("i")))
+					utils.ErrorCheckWithNamedArgs(g1)
+				}),
+			)
+		}
+		g.Return()
+	})
+}
+
+// Get the leading arguments of a function which talks to a node through the backend interface
+// `backendName`, which are the state and, if enabled, a context before it
+func (sg *StorageGenerator) backendArgs(backendName string) []jen.Code {
+	args := []jen.Code{}
+	if sg.tygen.WithContext {
+		args = append(args, jen.Id("ctx").Qual("context", "Context"))
+	}
+	return append(args, jen.Id("state").Custom(utils.TypeOpts, sg.tygen.BackendCode(backendName)))
+}
+
+// Prepend the context to the arguments of a call to the backend, if enabled
+func (sg *StorageGenerator) ctxArg(args ...jen.Code) []jen.Code {
+	if sg.tygen.WithContext {
+		return append([]jen.Code{jen.Id("ctx")}, args...)
+	}
+	return args
+}
+
+// Generate the code which builds the storage key for each of the input `keys`, along with a map from
+// each hex-encoded storage key to the indices of every input it belongs to, so duplicated keys are
+// still answered.
+//
+// example output:
+//
+//	skeys := make([]types.StorageKey, len(keys))
+//	indices := map[string][]int{}
+//	for i, k := range keys {
+//		skeys[i], err = MakeAccountStorageKey(k)
+//		if err != nil {
+//			return
+//		}
+//		indices[skeys[i].Hex()] = append(indices[skeys[i].Hex()], i)
+//	}
+func genKeysWithIndices(g *jen.Group, sKeyMethod string, keyAccessors []jen.Code) {
+	g.Id("skeys").Op(":=").Make(jen.Index().Qual(utils.CTYPES, "StorageKey"), jen.Len(jen.Id("keys")))
+	g.Id("indices").Op(":=").Map(jen.String()).Index().Int().Values()
+	g.For(jen.List(jen.Id("i"), jen.Id("k")).Op(":=").Range().Id("keys")).BlockFunc(func(g1 *jen.Group) {
+		g1.List(jen.Id("skeys").Index(jen.Id("i")), jen.Err()).Op("=").Id(sKeyMethod).Call(keyAccessors...)
+		utils.ErrorCheckWithNamedArgs(g1)
+		hexKey := jen.Id("skeys").Index(jen.Id("i")).Dot("Hex").Call()
+		g1.Id("indices").Index(hexKey).Op("=").Append(jen.Id("indices").Index(hexKey.Clone()), jen.Id("i"))
+	})
+}
+
+// Generate the types for the changes of a storage item across blocks, and an unexported function
+// which decodes a raw change set into them. For maps, each change holds the key it belongs to.
+// Removed values fall back to the default, if there is one.
+//
+// example output:
+//
+//	// A change to Account
+//	type AccountChange struct {
+//		Key   [32]byte
+//		Value types1.AccountInfo
+//	}
+//
+//	// The changes to Account in a single block
+//	type AccountChangeSet struct {
+//		Block   types.Hash
+//		Changes []AccountChange
+//	}
+//
+//	func decodeAccountChangeSet(raw types.StorageChangeSet, keys [][32]byte, indices map[string][]int) (set AccountChangeSet, err error) {
+//		set.Block = raw.Block
+//		for _, change := range raw.Changes {
+//			for _, i := range indices[change.StorageKey.Hex()] {
+//				c := AccountChange{Key: keys[i]}
+//				if change.HasStorageData {
+//					err = codec.Decode(change.StorageData, &c.Value)
+//				} else {
+//					err = codec.Decode(AccountResultDefaultBytes, &c.Value)
+//				}
+//				if err != nil {
+//					return
+//				}
+//				set.Changes = append(set.Changes, c)
+//			}
+//		}
+//		return
+//	}
+func (sg *StorageGenerator) generateChangeSet(keyType typegen.GeneratedType, returnType typegen.GeneratedType, item *types.StorageEntryMetadataV14) {
+	changeName := utils.AsName(string(item.Name), "Change")
+	setName := utils.AsName(string(item.Name), "ChangeSet")
+
+	sg.F.Comment(fmt.Sprintf("A change to %v", item.Name))
+	sg.F.Type().Id(changeName).StructFunc(func(g *jen.Group) {
+		if keyType != nil {
+			g.Id("Key").Custom(utils.TypeOpts, keyType.Code())
+		}
+		g.Id("Value").Custom(utils.TypeOpts, returnType.Code())
+		if item.Modifier.IsOptional {
+			g.Id("IsSome").Bool()
+		}
+	})
+	sg.F.Comment(fmt.Sprintf("The changes to %v in a single block", item.Name))
+	sg.F.Type().Id(setName).Struct(
+		jen.Id("Block").Qual(utils.CTYPES, "Hash"),
+		jen.Id("Changes").Index().Id(changeName),
+	)
+
+	args := []jen.Code{jen.Id("raw").Qual(utils.CTYPES, "StorageChangeSet")}
+	if keyType != nil {
+		args = append(args, jen.Id("keys").Index().Custom(utils.TypeOpts, keyType.Code()))
+	}
+	args = append(args, jen.Id("indices").Map(jen.String()).Index().Int())
+
+	sg.F.Func().Id(utils.AsArgName("decode", setName)).Call(args...).Call(
+		jen.Id("set").Id(setName), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		g.Id("set").Dot("Block").Op("=").Id("raw").Dot("Block")
+		// Plain items have no key, so they don't need the index
+		keyIndices := jen.Id("indices").Index(jen.Id("change").Dot("StorageKey").Dot("Hex").Call())
+		if keyType != nil {
+			keyIndices = jen.List(jen.Id("_"), jen.Id("i")).Op(":=").Range().Add(keyIndices)
+		} else {
+			keyIndices = jen.Range().Add(keyIndices)
+		}
+		g.For(jen.List(jen.Id("_"), jen.Id("change")).Op(":=").Range().Id("raw").Dot("Changes")).Block(
+			jen.For(keyIndices).BlockFunc(func(g1 *jen.Group) {
+				g1.Id("c").Op(":=").Id(changeName).Values(jen.DictFunc(func(d jen.Dict) {
+					if keyType != nil {
+						d[jen.Id("Key")] = jen.Id("keys").Index(jen.Id("i"))
+					}
+					if item.Modifier.IsOptional {
+						d[jen.Id("IsSome")] = jen.Id("change").Dot("HasStorageData")
+					}
+				}))
+				decodeNew := jen.Err().Op("=").Qual(utils.CCODEC, "Decode").Call(jen.Id("change").Dot("StorageData"), jen.Op("&").Id("c").Dot("Value"))
+				if item.Modifier.IsDefault {
+					defaultBytesName := utils.AsName(string(item.Name), "ResultDefaultBytes")
+					g1.If(jen.Id("change").Dot("HasStorageData")).Block(decodeNew).Else().Block(
+						jen.Err().Op("=").Qual(utils.CCODEC, "Decode").Call(jen.Id(defaultBytesName), jen.Op("&").Id("c").Dot("Value")),
+					)
+				} else {
+					g1.If(jen.Id("change").Dot("HasStorageData")).Block(decodeNew)
+				}
+				utils.ErrorCheckWithNamedArgs(g1)
+				g1.Id("set").Dot("Changes").Op("=").Append(jen.Id("set").Dot("Changes"), jen.Id("c"))
+			}),
+		)
+		g.Return()
+	})
+}
+
+// Generate the code which builds the storage keys of a storage item, and the map from each
+// hex-encoded storage key to the indices of the keys it belongs to. For maps, the keys are built
+// from the `keys` argument, and for plain items there is just the one key.
+func genItemKeys(g *jen.Group, sKeyMethod string, keyType typegen.GeneratedType, keyAccessors []jen.Code) {
+	if keyType != nil {
+		genKeysWithIndices(g, sKeyMethod, keyAccessors)
+		return
+	}
+	g.List(jen.Id("key"), jen.Err()).Op(":=").Id(sKeyMethod).Call()
+	utils.ErrorCheckWithNamedArgs(g)
+	g.Id("skeys").Op(":=").Index().Qual(utils.CTYPES, "StorageKey").Values(jen.Id("key"))
+	g.Id("indices").Op(":=").Map(jen.String()).Index().Int().Values(jen.Dict{
+		jen.Id("key").Dot("Hex").Call(): jen.Values(jen.Lit(0)),
+	})
+}
+
+// Get the arguments passed to the generated decode{..}ChangeSet function
+func changeSetDecodeArgs(keyType typegen.GeneratedType) []jen.Code {
+	if keyType != nil {
+		return []jen.Code{jen.Id("raw"), jen.Id("keys"), jen.Id("indices")}
+	}
+	return []jen.Code{jen.Id("raw"), jen.Id("indices")}
+}
+
+// Generate a function which subscribes to changes of a storage item with `state_subscribeStorage`,
+// delivering the change sets generated by generateChangeSet. For maps, the function takes the keys
+// to watch. Decoding or subscription errors are sent on the error channel, after which both
+// channels are closed.
+//
+// example output:
+//
+//	func SubscribeAccount(state state.State, keys ...[32]byte) (ret <-chan AccountChangeSet, errs <-chan error, unsubscribe func(), err error) {
+//		skeys := make([]types.StorageKey, len(keys))
+//		... (see genKeysWithIndices)
+//		sub, err := state.SubscribeStorageRaw(skeys)
+//		if err != nil {
+//			return
+//		}
+//		setc := make(chan AccountChangeSet)
+//		errc := make(chan error, 1)
+//		quit := make(chan struct{})
+//		go func() {
+//			defer close(setc)
+//			defer close(errc)
+//			for {
+//				select {
+//				case <-quit:
+//					return
+//				case err := <-sub.Err():
+//					if err != nil {
+//						errc <- err
+//					}
+//					return
+//				case raw, ok := <-sub.Chan():
+//					if !ok {
+//						return
+//					}
+//					set, err := decodeAccountChangeSet(raw, keys, indices)
+//					if err != nil {
+//						errc <- err
+//						sub.Unsubscribe()
+//						return
+//					}
+//					select {
+//					case setc <- set:
+//					case <-quit:
+//						return
+//					}
+//				}
+//			}
+//		}()
+//		var once sync.Once
+//		unsubscribe = func() {
+//			once.Do(func() {
+//				close(quit)
+//				sub.Unsubscribe()
+//			})
+//		}
+//		return setc, errc, unsubscribe, nil
+//	}
+func (sg *StorageGenerator) generateSubscription(sKeyMethod string, keyType typegen.GeneratedType, keyAccessors []jen.Code, item *types.StorageEntryMetadataV14) {
+	methodName := utils.AsName("Subscribe", string(item.Name))
+	setName := utils.AsName(string(item.Name), "ChangeSet")
+
+	args := sg.backendArgs(typegen.StorageSubscriberName)
+	if keyType != nil {
+		args = append(args, jen.Id("keys").Op("...").Custom(utils.TypeOpts, keyType.Code()))
+	}
+	ret := jen.List(
+		jen.Id("ret").Op("<-").Chan().Id(setName),
+		jen.Id("errs").Op("<-").Chan().Error(),
+		jen.Id("unsubscribe").Func().Params(),
+		jen.Err().Error(),
+	)
+
+	sg.F.Func().Id(methodName).Call(args...).Call(ret).BlockFunc(func(g *jen.Group) {
+		genItemKeys(g, sKeyMethod, keyType, keyAccessors)
+		g.List(jen.Id("sub"), jen.Err()).Op(":=").Id("state").Dot("SubscribeStorageRaw").Call(sg.ctxArg(jen.Id("skeys"))...)
+		utils.ErrorCheckWithNamedArgs(g)
+
+		g.Id("setc").Op(":=").Make(jen.Chan().Id(setName))
+		g.Id("errc").Op(":=").Make(jen.Chan().Error(), jen.Lit(1))
+		g.Id("quit").Op(":=").Make(jen.Chan().Struct())
+
+		// Forward raw change sets as typed change sets until unsubscribed or an error occurs
+		g.Go().Func().Params().BlockFunc(func(g1 *jen.Group) {
+			g1.Defer().Close(jen.Id("setc"))
+			g1.Defer().Close(jen.Id("errc"))
+			g1.For().Block(jen.Select().BlockFunc(func(g2 *jen.Group) {
+				g2.Case(jen.Op("<-").Id("quit")).Block(jen.Return())
+				if sg.tygen.WithContext {
+					// A cancelled context ends the subscription like unsubscribing does
+					g2.Case(jen.Op("<-").Id("ctx").Dot("Done").Call()).Block(
+						jen.Id("errc").Op("<-").Id("ctx").Dot("Err").Call(),
+						jen.Id("sub").Dot("Unsubscribe").Call(),
+						jen.Return(),
+					)
+				}
+				g2.Case(jen.Err().Op(":=").Op("<-").Id("sub").Dot("Err").Call()).Block(
+					jen.If(jen.Err().Op("!=").Nil()).Block(jen.Id("errc").Op("<-").Err()),
+					jen.Return(),
+				)
+				g2.Case(jen.List(jen.Id("raw"), jen.Id("ok")).Op(":=").Op("<-").Id("sub").Dot("Chan").Call()).Block(
+					jen.If(jen.Op("!").Id("ok")).Block(jen.Return()),
+					jen.List(jen.Id("set"), jen.Err()).Op(":=").Id(utils.AsArgName("decode", setName)).Call(changeSetDecodeArgs(keyType)...),
+					jen.If(jen.Err().Op("!=").Nil()).Block(
+						jen.Id("errc").Op("<-").Err(),
+						jen.Id("sub").Dot("Unsubscribe").Call(),
+						jen.Return(),
+					),
+					jen.Select().Block(
+						jen.Case(jen.Id("setc").Op("<-").Id("set")),
+						jen.Case(jen.Op("<-").Id("quit")).Block(jen.Return()),
+					),
+				)
+			}))
+		}).Call()
+
+		g.Var().Id("once").Qual("sync", "Once")
+		g.Id("unsubscribe").Op("=").Func().Params().Block(
+			jen.Id("once").Dot("Do").Call(jen.Func().Params().Block(
+				jen.Close(jen.Id("quit")),
+				jen.Id("sub").Dot("Unsubscribe").Call(),
+			)),
+		)
+		g.Return(jen.Id("setc"), jen.Id("errc"), jen.Id("unsubscribe"), jen.Nil())
+	})
+}
+
+// Generate a function which queries the history of a storage item over a range of blocks with
+// `state_queryStorage`, returning a change set for every block in which the value of one of the keys
+// changed. If `withBlockHash`, the range ends at a particular block hash, otherwise it ends at the
+// latest block and the function is named latest.
+//
+// example output:
+//
+//	func QueryAccountRange(state state.State, from types.Hash, to types.Hash, keys ...[32]byte) (ret []AccountChangeSet, err error) {
+//		skeys := make([]types.StorageKey, len(keys))
+//		... (see genKeysWithIndices)
+//		raws, err := state.QueryStorage(skeys, from, to)
+//		if err != nil {
+//			return
+//		}
+//		for _, raw := range raws {
+//			var set AccountChangeSet
+//			set, err = decodeAccountChangeSet(raw, keys, indices)
+//			if err != nil {
+//				return
+//			}
+//			if len(set.Changes) > 0 {
+//				ret = append(ret, set)
+//			}
+//		}
+//		return
+//	}
+func (sg *StorageGenerator) generateRangeQuery(withBlockhash bool, sKeyMethod string, keyType typegen.GeneratedType, keyAccessors []jen.Code, item *types.StorageEntryMetadataV14) {
+	setName := utils.AsName(string(item.Name), "ChangeSet")
+
+	args := append(sg.backendArgs(typegen.StorageQuerierName), jen.Id("from").Qual(utils.CTYPES, "Hash"))
+	if withBlockhash {
+		args = append(args, jen.Id("to").Qual(utils.CTYPES, "Hash"))
+	}
+	if keyType != nil {
+		args = append(args, jen.Id("keys").Op("...").Custom(utils.TypeOpts, keyType.Code()))
+	}
+
+	var methodName string
+	if withBlockhash {
+		methodName = utils.AsName("Query", string(item.Name), "Range")
+	} else {
+		methodName = utils.AsName("Query", string(item.Name), "Range", "Latest")
+	}
+
+	sg.F.Func().Id(methodName).Call(args...).Call(
+		jen.Id("ret").Index().Id(setName), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		genItemKeys(g, sKeyMethod, keyType, keyAccessors)
+		if withBlockhash {
+			g.List(jen.Id("raws"), jen.Err()).Op(":=").Id("state").Dot("QueryStorage").Call(sg.ctxArg(jen.Id("skeys"), jen.Id("from"), jen.Id("to"))...)
+		} else {
+			g.List(jen.Id("raws"), jen.Err()).Op(":=").Id("state").Dot("QueryStorageLatest").Call(sg.ctxArg(jen.Id("skeys"), jen.Id("from"))...)
+		}
+		utils.ErrorCheckWithNamedArgs(g)
+		g.For(jen.List(jen.Id("_"), jen.Id("raw")).Op(":=").Range().Id("raws")).BlockFunc(func(g1 *jen.Group) {
+			g1.Var().Id("set").Id(setName)
+			g1.List(jen.Id("set"), jen.Err()).Op("=").Id(utils.AsArgName("decode", setName)).Call(changeSetDecodeArgs(keyType)...)
+			utils.ErrorCheckWithNamedArgs(g1)
+			g1.If(jen.Len(jen.Id("set").Dot("Changes")).Op(">").Lit(0)).Block(
+				jen.Id("ret").Op("=").Append(jen.Id("ret"), jen.Id("set")),
+			)
+		})
+		g.Return()
+	})
+}
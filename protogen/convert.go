@@ -0,0 +1,646 @@
+package protogen
+
+import (
+	"fmt"
+
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/dave/jennifer/jen"
+)
+
+// A field of a generated struct, tuple or variant, and the field of the message it converts to
+type structField struct {
+	goName string
+	isPtr  bool
+	gend   typegen.GeneratedType
+	proto  field
+}
+
+// The state of a converter function being generated
+type conv struct {
+	pg *ProtoGenerator
+	// Generate the error check of a call in the function
+	errCheck func(g *jen.Group)
+	// Generate the return of an error
+	fail func(err jen.Code) jen.Code
+	// The number of temporary variables so far
+	n int
+	// The first error generating the function
+	err error
+}
+
+// Get a new temporary variable name
+func (c *conv) tmp(prefix string) string {
+	c.n += 1
+	return fmt.Sprintf("%v%v", prefix, c.n)
+}
+
+// Record an error, keeping the first
+func (c *conv) setErr(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+// The converters of a type which produce its message, and of a message which produce the type
+func toName(name string) string   { return name + "ToProto" }
+func fromName(name string) string { return name + "FromProto" }
+
+// Generate the functions converting a struct, variant or tuple to and from its message.
+//
+// example output:
+//
+//	// Convert a types.AccountData into its protobuf message
+//	func AccountDataToProto(v types.AccountData) (m *typespb.AccountData, err error) {
+//		m = &typespb.AccountData{}
+//		m.Free = bigToProto(v.Free.Int)
+//		m.Flags = v.Flags
+//		return m, nil
+//	}
+//
+//	// Convert a protobuf message into a types.AccountData
+//	func AccountDataFromProto(m *typespb.AccountData) (v types.AccountData, err error) {
+//		b1, err := bigFromProto(m.GetFree())
+//		if err != nil {
+//			return v, err
+//		}
+//		v.Free = types1.NewU128(*b1)
+//		v.Flags = m.GetFlags()
+//		return v, nil
+//	}
+func (pg *ProtoGenerator) generateConverters(gend typegen.GeneratedType, m *message) error {
+	name := gend.DisplayName()
+	goType := jen.Qual(pg.tygen.PkgPath, name)
+	pbName := goCamelCase(m.name)
+	pbType := jen.Op("*").Qual(pg.pbPath, pbName)
+	names, _ := goNames(m)
+
+	vg, isVariant := gend.(*typegen.VariantGend)
+	var fields []structField
+	if !isVariant {
+		var err error
+		fields, err = pg.structFields(gend, m)
+		if err != nil {
+			return err
+		}
+	}
+
+	to := &conv{pg: pg, errCheck: utils.ErrorCheckWithNil, fail: func(err jen.Code) jen.Code { return jen.Return(jen.Nil(), err) }}
+	pg.F.Comment(fmt.Sprintf("Convert a types.%v into its protobuf message", name))
+	pg.F.Func().Id(toName(name)).Params(jen.Id("v").Add(goType)).Params(
+		jen.Id("m").Add(pbType), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		if isVariant {
+			to.variantToProto(g, vg, m, pbName)
+			return
+		}
+		g.Id("m").Op("=").Op("&").Qual(pg.pbPath, pbName).Values()
+		to.fieldsToProto(g, fields, jen.Id("v"), jen.Id("m"), names)
+		g.Return(jen.Id("m"), jen.Nil())
+	})
+	if to.err != nil {
+		return to.err
+	}
+
+	// The errors of calls in nested blocks shadow err, so they're returned explicitly
+	fromCheck := func(g *jen.Group) { g.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Id("v"), jen.Err())) }
+	from := &conv{pg: pg, errCheck: fromCheck, fail: func(err jen.Code) jen.Code { return jen.Return(jen.Id("v"), err) }}
+	pg.F.Comment(fmt.Sprintf("Convert a protobuf message into a types.%v", name))
+	pg.F.Func().Id(fromName(name)).Params(jen.Id("m").Add(pbType)).Params(
+		jen.Id("v").Add(goType), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		if isVariant {
+			from.variantFromProto(g, vg, m, pbName)
+			return
+		}
+		from.fieldsFromProto(g, fields, jen.Id("m"), jen.Id("v"), names)
+		g.Return(jen.Id("v"), jen.Nil())
+	})
+	return from.err
+}
+
+// Get the fields of a struct or tuple, with the fields of its message
+func (pg *ProtoGenerator) structFields(gend typegen.GeneratedType, m *message) ([]structField, error) {
+	fields := []structField{}
+	mt := gend.MType()
+	if cg, ok := gend.(*typegen.CompositeGend); ok {
+		for i, gf := range cg.Fields {
+			fGend, err := pg.generated(mt.Type.Def.Composite.Fields[i].Type.Int64())
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, structField{goName: gf.Name, isPtr: gf.IsPtr, gend: fGend, proto: m.fields[i]})
+		}
+		return fields, nil
+	}
+	for i, id := range mt.Type.Def.Tuple {
+		fGend, err := pg.generated(id.Int64())
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, structField{goName: utils.AsName("Elem", fmt.Sprint(i)), gend: fGend, proto: m.fields[i]})
+	}
+	return fields, nil
+}
+
+// Generate the conversion of the fields of the go value `src` into the fields of the message `dst`,
+// whose go names are `names`
+func (c *conv) fieldsToProto(g *jen.Group, fields []structField, src, dst jen.Code, names map[string]string) {
+	for _, f := range fields {
+		in := jen.Add(src).Dot(f.goName)
+		out := jen.Add(dst).Dot(names[f.proto.name])
+		if !f.isPtr {
+			val := c.toProto(g, f.gend, in, !f.proto.repeated)
+			g.Add(out).Op("=").Add(val)
+			continue
+		}
+		// A nil pointer leaves the field unset
+		g.If(jen.Add(in).Op("!=").Nil()).BlockFunc(func(g1 *jen.Group) {
+			val := c.toProto(g1, f.gend, jen.Parens(jen.Op("*").Add(in)), !f.proto.repeated)
+			g1.Add(out).Op("=").Add(val)
+		})
+	}
+}
+
+// Generate the conversion of the fields of the message `src`, whose go names are `names`, into the
+// fields of the go value `dst`
+func (c *conv) fieldsFromProto(g *jen.Group, fields []structField, src, dst jen.Code, names map[string]string) {
+	for _, f := range fields {
+		in := jen.Add(src).Dot("Get" + names[f.proto.name]).Call()
+		c.fieldFromProto(g, f, in, jen.Add(dst).Dot(f.goName))
+	}
+}
+
+// Generate the conversion of the protobuf value `in` into the field `out`
+func (c *conv) fieldFromProto(g *jen.Group, f structField, in, out jen.Code) {
+	// Lists which aren't repeated fields are wrapped in a message
+	singular := !f.proto.repeated
+	if !f.isPtr {
+		val := c.fromProto(g, f.gend, in, singular)
+		g.Add(out).Op("=").Add(val)
+		return
+	}
+	convert := func(g1 *jen.Group) {
+		p := c.tmp("p")
+		val := c.fromProto(g1, f.gend, in, singular)
+		g1.Id(p).Op(":=").Add(val)
+		g1.Add(out).Op("=").Op("&").Id(p)
+	}
+	if isMessage(f.proto.typ) && !f.proto.repeated {
+		// An unset message leaves the pointer nil
+		g.If(jen.Add(in).Op("!=").Nil()).BlockFunc(convert)
+	} else {
+		convert(g)
+	}
+}
+
+// Generate the conversion of a variant into its message, which sets the oneof case of the variant
+// which is set.
+//
+// example output:
+//
+//	if v.IsActive {
+//		return &typespb.Status{Variant: &typespb.Status_Active{Active: &typespb.Empty{}}}, nil
+//	}
+//	if v.IsFrozen {
+//		c := &typespb.Status_Frozen{}
+//		c.Frozen = uint32(v.AsFrozenField0)
+//		return &typespb.Status{Variant: c}, nil
+//	}
+//	return nil, fmt.Errorf("no variant of Status is set")
+func (c *conv) variantToProto(g *jen.Group, vg *typegen.VariantGend, m *message, pbName string) {
+	names, oneof := goNames(m)
+	ret := func(g *jen.Group, value jen.Code) {
+		g.Return(jen.Op("&").Qual(c.pg.pbPath, pbName).Values(jen.Dict{jen.Id(oneof): value}), jen.Nil())
+	}
+	for i, v := range vg.MTy.Type.Def.Variant.Variants {
+		f := m.fields[i]
+		caseType := jen.Qual(c.pg.pbPath, caseName(m, pbName, names[f.name]))
+		g.If(jen.Id("v").Dot(vg.IsVarFields[i].Name)).BlockFunc(func(g1 *jen.Group) {
+			switch len(v.Fields) {
+			case 0:
+				ret(g1, jen.Op("&").Add(caseType).Values(jen.Dict{
+					jen.Id(names[f.name]): jen.Op("&").Qual(c.pg.pbPath, emptyName).Values(),
+				}))
+			case 1:
+				g1.Id("c").Op(":=").Op("&").Add(caseType).Values()
+				c.fieldsToProto(g1, c.variantField(vg, i, f), jen.Id("v"), jen.Id("c"), names)
+				ret(g1, jen.Id("c"))
+			default:
+				nested := m.nested[nestedIndex(m, f.typ)]
+				nestedNames, _ := goNames(nested)
+				g1.Id("f").Op(":=").Op("&").Qual(c.pg.pbPath, pbName+"_"+nested.name).Values()
+				c.fieldsToProto(g1, c.nestedFields(vg, i, nested), jen.Id("v"), jen.Id("f"), nestedNames)
+				ret(g1, jen.Op("&").Add(caseType).Values(jen.Dict{jen.Id(names[f.name]): jen.Id("f")}))
+			}
+		})
+	}
+	g.Add(c.fail(jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("no variant of %v is set", vg.Name)))))
+}
+
+// Generate the conversion of a message into a variant, which sets the variant of the oneof case
+// which is set.
+//
+// example output:
+//
+//	switch c := m.GetVariant().(type) {
+//	case *typespb.Status_Active:
+//		v.IsActive = true
+//	case *typespb.Status_Frozen:
+//		v.IsFrozen = true
+//		v.AsFrozenField0 = byte(c.Frozen)
+//	default:
+//		return v, fmt.Errorf("no variant of Status is set")
+//	}
+//	return v, nil
+func (c *conv) variantFromProto(g *jen.Group, vg *typegen.VariantGend, m *message, pbName string) {
+	if len(m.fields) == 0 {
+		// The message has no oneof
+		g.Add(c.fail(jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("no variant of %v is set", vg.Name)))))
+		return
+	}
+	names, oneof := goNames(m)
+	value := jen.Id("m").Dot("Get" + oneof).Call().Assert(jen.Type())
+	// The case's value is only used by variants with fields
+	for _, v := range vg.MTy.Type.Def.Variant.Variants {
+		if len(v.Fields) > 0 {
+			value = jen.Id("c").Op(":=").Add(value)
+			break
+		}
+	}
+
+	g.Switch(value).BlockFunc(func(g1 *jen.Group) {
+		for i, v := range vg.MTy.Type.Def.Variant.Variants {
+			f := m.fields[i]
+			g1.Case(jen.Op("*").Qual(c.pg.pbPath, caseName(m, pbName, names[f.name]))).BlockFunc(func(g2 *jen.Group) {
+				g2.Id("v").Dot(vg.IsVarFields[i].Name).Op("=").True()
+				switch len(v.Fields) {
+				case 0:
+				case 1:
+					// Cases have no getters
+					vf := c.variantField(vg, i, f)[0]
+					c.fieldFromProto(g2, vf, jen.Id("c").Dot(names[f.name]), jen.Id("v").Dot(vf.goName))
+				default:
+					nested := m.nested[nestedIndex(m, f.typ)]
+					nestedNames, _ := goNames(nested)
+					c.fieldsFromProto(g2, c.nestedFields(vg, i, nested), jen.Id("c").Dot(names[f.name]), jen.Id("v"), nestedNames)
+				}
+			})
+		}
+		g1.Default().Block(
+			c.fail(jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("no variant of %v is set", vg.Name)))),
+		)
+	})
+	g.Return(jen.Id("v"), jen.Nil())
+}
+
+// Get the field of the i'th variant of a variant with one field, and the oneof case it converts to
+func (c *conv) variantField(vg *typegen.VariantGend, i int, f field) []structField {
+	gend, err := c.pg.generated(vg.MTy.Type.Def.Variant.Variants[i].Fields[0].Type.Int64())
+	c.setErr(err)
+	gf := vg.AsVarFields[i][0]
+	return []structField{{goName: gf.Name, isPtr: gf.IsPtr, gend: gend, proto: f}}
+}
+
+// Get the fields of the i'th variant of a variant with several fields, and the fields of the nested
+// message they convert to
+func (c *conv) nestedFields(vg *typegen.VariantGend, i int, nested *message) []structField {
+	fields := []structField{}
+	for j, vf := range vg.MTy.Type.Def.Variant.Variants[i].Fields {
+		gend, err := c.pg.generated(vf.Type.Int64())
+		c.setErr(err)
+		gf := vg.AsVarFields[i][j]
+		fields = append(fields, structField{goName: gf.Name, isPtr: gf.IsPtr, gend: gend, proto: nested.fields[j]})
+	}
+	return fields
+}
+
+// Generate the conversion of the go value `in` into its protobuf value, adding the statements it
+// needs to `g`, and return the value. `in` must be addressable. If `singular`, lists are converted
+// into the message wrapping them.
+func (c *conv) toProto(g *jen.Group, gend typegen.GeneratedType, in jen.Code, singular bool) jen.Code {
+	pg := c.pg
+	switch t := gend.(type) {
+	case *typegen.PrimitiveGend:
+		switch t.PrimName {
+		case "bool", "string", "uint32", "uint64", "int32", "int64":
+			return in
+		case "byte", "uint16":
+			return jen.Uint32().Call(in)
+		case "int8", "int16", "rune":
+			return jen.Int32().Call(in)
+		case "struct{}":
+			return jen.Op("&").Qual(pg.pbPath, emptyName).Values()
+		}
+	case *typegen.ArrayGend:
+		if isByte(t.Inner) {
+			return jen.Add(in).Index(jen.Op(":"))
+		}
+		return c.listToProto(g, t, t.Inner, in, singular)
+	case *typegen.SliceGend:
+		if isByte(t.Inner) {
+			return in
+		}
+		return c.listToProto(g, t, t.Inner, in, singular)
+	case *typegen.CompositeGend:
+		return c.call(g, toName(t.Name), in)
+	case *typegen.VariantGend:
+		return c.call(g, toName(t.Name), in)
+	case *typegen.Gend:
+		if t.Pkg == pg.tygen.PkgPath {
+			return c.call(g, toName(t.Name), in)
+		}
+		switch t.Name {
+		case "U128", "U256", "I128", "I256":
+			pg.helpers["bigToProto"] = true
+			return jen.Id("bigToProto").Call(jen.Add(in).Dot("Int"))
+		case "UCompact":
+			pg.helpers["bigToProto"] = true
+			return jen.Id("bigToProto").Call(jen.Parens(jen.Op("*").Qual("math/big", "Int")).Call(jen.Op("&").Add(in)))
+		}
+	}
+	c.setErr(fmt.Errorf("unexpected generated type %v", gend.DisplayName()))
+	return jen.Null()
+}
+
+// Generate the conversion of a list into a repeated field, or into the message wrapping it if
+// `singular`
+func (c *conv) listToProto(g *jen.Group, list, inner typegen.GeneratedType, in jen.Code, singular bool) jen.Code {
+	typ, _, err := c.pg.protoType(inner, true)
+	c.setErr(err)
+	res, e := c.tmp("l"), c.tmp("e")
+	g.Id(res).Op(":=").Make(jen.Index().Add(c.pg.pbGoType(typ)), jen.Lit(0), jen.Len(in))
+	g.For(jen.List(jen.Id("_"), jen.Id(e)).Op(":=").Range().Add(in)).BlockFunc(func(g1 *jen.Group) {
+		val := c.toProto(g1, inner, jen.Id(e), true)
+		g1.Id(res).Op("=").Append(jen.Id(res), val)
+	})
+	if singular {
+		return jen.Op("&").Qual(c.pg.pbPath, goCamelCase(list.DisplayName())).Values(jen.Dict{jen.Id("Items"): jen.Id(res)})
+	}
+	return jen.Id(res)
+}
+
+// Generate the conversion of the protobuf value `in` into the go type `gend`, adding the statements
+// it needs to `g`, and return the value. If `singular`, lists are converted from the message
+// wrapping them.
+func (c *conv) fromProto(g *jen.Group, gend typegen.GeneratedType, in jen.Code, singular bool) jen.Code {
+	pg := c.pg
+	switch t := gend.(type) {
+	case *typegen.PrimitiveGend:
+		switch t.PrimName {
+		case "bool", "string", "uint32", "uint64", "int32", "int64":
+			return in
+		case "rune":
+			return jen.Id(t.PrimName).Call(in)
+		case "byte", "uint16", "int8", "int16":
+			return c.narrowFromProto(g, t.PrimName, in)
+		case "struct{}":
+			return jen.Struct().Values()
+		}
+	case *typegen.ArrayGend:
+		if singular && !isByte(t.Inner) {
+			in = jen.Add(in).Dot("GetItems").Call()
+		}
+		return c.arrayFromProto(g, t, in)
+	case *typegen.SliceGend:
+		if isByte(t.Inner) {
+			return in
+		}
+		if singular {
+			in = jen.Add(in).Dot("GetItems").Call()
+		}
+		res, e := c.tmp("l"), c.tmp("e")
+		g.Id(res).Op(":=").Make(jen.Index().Custom(utils.TypeOpts, t.Inner.Code()), jen.Lit(0), jen.Len(in))
+		g.For(jen.List(jen.Id("_"), jen.Id(e)).Op(":=").Range().Add(in)).BlockFunc(func(g1 *jen.Group) {
+			val := c.fromProto(g1, t.Inner, jen.Id(e), true)
+			g1.Id(res).Op("=").Append(jen.Id(res), val)
+		})
+		return jen.Id(res)
+	case *typegen.CompositeGend:
+		return c.call(g, fromName(t.Name), in)
+	case *typegen.VariantGend:
+		return c.call(g, fromName(t.Name), in)
+	case *typegen.Gend:
+		if t.Pkg == pg.tygen.PkgPath {
+			return c.call(g, fromName(t.Name), in)
+		}
+		switch t.Name {
+		case "U128", "U256", "I128", "I256", "UCompact":
+			pg.helpers["bigFromProto"] = true
+			b := c.tmp("b")
+			g.List(jen.Id(b), jen.Err()).Op(":=").Id("bigFromProto").Call(in)
+			c.errCheck(g)
+			if t.Name == "UCompact" {
+				return jen.Qual(utils.CTYPES, "NewUCompact").Call(jen.Id(b))
+			}
+			return jen.Qual(utils.CTYPES, "New"+t.Name).Call(jen.Op("*").Id(b))
+		}
+	}
+	c.setErr(fmt.Errorf("unexpected generated type %v", gend.DisplayName()))
+	return jen.Null()
+}
+
+// Generate the conversion of bytes or a repeated field into an array, which fails if their length
+// isn't the array's
+func (c *conv) arrayFromProto(g *jen.Group, t *typegen.ArrayGend, in jen.Code) jen.Code {
+	res := c.tmp("a")
+	g.If(jen.Len(in).Op("!=").Lit(t.Len)).Block(
+		c.fail(jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("expected %v items, got %%v", t.Len)), jen.Len(in))),
+	)
+	g.Var().Id(res).Custom(utils.TypeOpts, t.Code())
+	if isByte(t.Inner) {
+		g.Copy(jen.Id(res).Index(jen.Op(":")), in)
+		return jen.Id(res)
+	}
+	i, e := c.tmp("i"), c.tmp("e")
+	g.For(jen.List(jen.Id(i), jen.Id(e)).Op(":=").Range().Add(in)).BlockFunc(func(g1 *jen.Group) {
+		val := c.fromProto(g1, t.Inner, jen.Id(e), true)
+		g1.Id(res).Index(jen.Id(i)).Op("=").Add(val)
+	})
+	return jen.Id(res)
+}
+
+// The range of the integer types narrower than their protobuf field
+var narrowRanges = map[string][2]string{
+	"byte":   {"", "MaxUint8"},
+	"uint16": {"", "MaxUint16"},
+	"int8":   {"MinInt8", "MaxInt8"},
+	"int16":  {"MinInt16", "MaxInt16"},
+}
+
+// Generate the conversion of a 32 bit protobuf integer into the narrower integer type `name`,
+// which fails if it's out of range
+func (c *conv) narrowFromProto(g *jen.Group, name string, in jen.Code) jen.Code {
+	rng := narrowRanges[name]
+	n := c.tmp("n")
+	g.Id(n).Op(":=").Add(in)
+	cond := jen.Id(n).Op(">").Qual("math", rng[1])
+	if rng[0] != "" {
+		cond = jen.Id(n).Op("<").Qual("math", rng[0]).Op("||").Add(cond)
+	}
+	g.If(cond).Block(
+		c.fail(jen.Qual("fmt", "Errorf").Call(jen.Lit(fmt.Sprintf("%%v overflows %v", name)), jen.Id(n))),
+	)
+	return jen.Id(name).Call(jen.Id(n))
+}
+
+// Generate a call of another converter, and return its result
+func (c *conv) call(g *jen.Group, fn string, in jen.Code) jen.Code {
+	res := c.tmp("t")
+	g.List(jen.Id(res), jen.Err()).Op(":=").Id(fn).Call(in)
+	c.errCheck(g)
+	return jen.Id(res)
+}
+
+// Generate the helper functions used by the converters.
+//
+// example output:
+//
+//	// Get the decimal string of a big integer, which is 0 if it's nil
+//	func bigToProto(i *big.Int) string {...}
+//
+//	// Parse the decimal string of a big integer, which is 0 if it's empty
+//	func bigFromProto(s string) (*big.Int, error) {...}
+func (pg *ProtoGenerator) generateHelpers() {
+	if pg.helpers["bigToProto"] {
+		pg.F.Comment("Get the decimal string of a big integer, which is 0 if it's nil")
+		pg.F.Func().Id("bigToProto").Params(jen.Id("i").Op("*").Qual("math/big", "Int")).String().Block(
+			jen.If(jen.Id("i").Op("==").Nil()).Block(jen.Return(jen.Lit("0"))),
+			jen.Return(jen.Id("i").Dot("String").Call()),
+		)
+	}
+	if pg.helpers["bigFromProto"] {
+		pg.F.Comment("Parse the decimal string of a big integer, which is 0 if it's empty")
+		pg.F.Func().Id("bigFromProto").Params(jen.Id("s").String()).Params(jen.Op("*").Qual("math/big", "Int"), jen.Error()).Block(
+			jen.If(jen.Id("s").Op("==").Lit("")).Block(jen.Return(jen.New(jen.Qual("math/big", "Int")), jen.Nil())),
+			jen.List(jen.Id("i"), jen.Id("ok")).Op(":=").New(jen.Qual("math/big", "Int")).Dot("SetString").Call(jen.Id("s"), jen.Lit(10)),
+			jen.If(jen.Op("!").Id("ok")).Block(
+				jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(jen.Lit("bad integer %q"), jen.Id("s"))),
+			),
+			jen.Return(jen.Id("i"), jen.Nil()),
+		)
+	}
+}
+
+// Get the go type protoc-gen-go generates for the items of a repeated field
+func (pg *ProtoGenerator) pbGoType(typ string) jen.Code {
+	switch typ {
+	case "bool":
+		return jen.Bool()
+	case "string":
+		return jen.String()
+	case "uint32":
+		return jen.Uint32()
+	case "uint64":
+		return jen.Uint64()
+	case "sint32":
+		return jen.Int32()
+	case "sint64":
+		return jen.Int64()
+	case "bytes":
+		return jen.Index().Byte()
+	}
+	return jen.Op("*").Qual(pg.pbPath, goCamelCase(typ))
+}
+
+func isMessage(typ string) bool {
+	switch typ {
+	case "bool", "string", "uint32", "uint64", "sint32", "sint64", "bytes":
+		return false
+	}
+	return true
+}
+
+// Get the index of the message nested in `m` with the given name
+func nestedIndex(m *message, name string) int {
+	for i, nested := range m.nested {
+		if nested.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Get the go names protoc-gen-go gives a message's fields, and its oneof. Names which would conflict
+// with the methods of messages get a _ suffix, like protoc-gen-go does.
+func goNames(m *message) (map[string]string, string) {
+	used := map[string]bool{
+		"Reset":               true,
+		"String":              true,
+		"ProtoMessage":        true,
+		"Marshal":             true,
+		"Unmarshal":           true,
+		"ExtensionRangeArray": true,
+		"ExtensionMap":        true,
+		"Descriptor":          true,
+	}
+	unique := func(name string, hasGetter bool) string {
+		for used[name] || (hasGetter && used["Get"+name]) {
+			name += "_"
+		}
+		used[name] = true
+		used["Get"+name] = hasGetter
+		return name
+	}
+	names := map[string]string{}
+	oneof := ""
+	for i, f := range m.fields {
+		names[f.name] = unique(goCamelCase(f.name), true)
+		if m.oneof && i == 0 {
+			// protoc-gen-go names the oneof after its first field
+			oneof = unique(goCamelCase(oneofName), false)
+		}
+	}
+	return names, oneof
+}
+
+// Get the go name protoc-gen-go gives the type of a oneof case, which gets a _ suffix if it conflicts
+// with a nested message
+func caseName(m *message, pbName string, fieldName string) string {
+	name := pbName + "_" + fieldName
+	for {
+		conflict := false
+		for _, nested := range m.nested {
+			if pbName+"_"+nested.name == name {
+				conflict = true
+			}
+		}
+		if !conflict {
+			return name
+		}
+		name += "_"
+	}
+}
+
+// Turn a protobuf name into a go name like protoc-gen-go does, e.g. pays_fee -> PaysFee
+func goCamelCase(s string) string {
+	isLower := func(c byte) bool { return 'a' <= c && c <= 'z' }
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+	b := []byte{}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '.' && i+1 < len(s) && isLower(s[i+1]):
+			// Skip over '.' in ".{{lowercase}}"
+		case c == '.':
+			b = append(b, '_')
+		case c == '_' && (i == 0 || s[i-1] == '.'):
+			// Start with a capital letter
+			b = append(b, 'X')
+		case c == '_' && i+1 < len(s) && isLower(s[i+1]):
+			// Skip over '_' in "_{{lowercase}}"
+		case isDigit(c):
+			b = append(b, c)
+		default:
+			// The next word starts upper case, and is followed by its lower case letters
+			if isLower(c) {
+				c -= 'a' - 'A'
+			}
+			b = append(b, c)
+			for ; i+1 < len(s) && isLower(s[i+1]); i++ {
+				b = append(b, s[i+1])
+			}
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,407 @@
+package protogen
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/dave/jennifer/jen"
+)
+
+// The proto generator generates protobuf messages for the generated types, in typespb/types.proto,
+// and a protoconv package converting between the generated types and the go types protoc-gen-go
+// generates from the messages, for services exposing chain data over gRPC.
+//
+// Structs and tuples are messages, variants are messages with a oneof of their variants, slices and
+// arrays are repeated fields, and the big integers are decimal strings. Like the doc generator, it
+// must run once the code is generated, and only uses types the TypeGenerator already generated.
+type ProtoGenerator struct {
+	F     *jen.File
+	tygen *typegen.TypeGenerator
+	// The package path of the go code protoc-gen-go generates from the messages
+	pbPath string
+	// The protobuf package of the messages
+	protoPkg string
+	// The messages of the defined types, by the name of the type
+	defined map[string]*message
+	// The messages wrapping lists, which can't be repeated fields of oneofs or lists, by name
+	wrappers map[string]*message
+	// The helper functions the converters use, which are generated at the end
+	helpers map[string]bool
+}
+
+// The messages are written into the typespb directory, which protoc-gen-go generates the go code of
+const ProtoFile = "typespb/types.proto"
+
+// The message of fieldless variants and of ()
+const emptyName = "Empty"
+
+// The name of the oneof of a variant's message
+const oneofName = "variant"
+
+// A protobuf message
+type message struct {
+	name string
+	// The rust type it's generated from, if any
+	rustPath string
+	fields   []field
+	// Whether the fields are the cases of a oneof, rather than fields
+	oneof  bool
+	nested []*message
+}
+
+// A field of a message
+type field struct {
+	name     string
+	number   int
+	typ      string
+	repeated bool
+}
+
+func NewProtoGenerator(pkgPath string, tygen *typegen.TypeGenerator) ProtoGenerator {
+	F := jen.NewFilePath(path.Join(pkgPath, "/protoconv"))
+	F.PackageComment("Package protoconv converts between the generated types and the protobuf messages generated from")
+	F.PackageComment("typespb/types.proto with protoc-gen-go.")
+
+	segments := []string{}
+	for _, s := range strings.Split(pkgPath, "/") {
+		segments = append(segments, protoIdent(s))
+	}
+	segments = append(segments, "types")
+	return ProtoGenerator{
+		F:        F,
+		tygen:    tygen,
+		pbPath:   path.Join(pkgPath, "/typespb"),
+		protoPkg: strings.Join(segments, "."),
+		defined:  map[string]*message{},
+		wrappers: map[string]*message{},
+		helpers:  map[string]bool{},
+	}
+}
+
+// Generate the protobuf messages and the converters, and return the .proto file and the go file
+func (pg *ProtoGenerator) Generate() (string, string, error) {
+	defined := pg.tygen.DefinedTypes()
+	names := make([]string, 0, len(defined))
+	for name := range defined {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if name == emptyName {
+			return "", "", fmt.Errorf("the type %v has the name of the empty message", name)
+		}
+		m, err := pg.definedMessage(defined[name])
+		if err != nil {
+			return "", "", fmt.Errorf("type %v: %v", name, err)
+		}
+		pg.defined[name] = m
+	}
+	for name := range pg.wrappers {
+		if _, ok := pg.defined[name]; ok || name == emptyName {
+			return "", "", fmt.Errorf("the list message %v has the name of another message", name)
+		}
+	}
+
+	for _, name := range names {
+		if err := pg.generateConverters(defined[name], pg.defined[name]); err != nil {
+			return "", "", fmt.Errorf("type %v: %v", name, err)
+		}
+	}
+	pg.generateHelpers()
+	return pg.protoFile(), fmt.Sprintf("%#v", pg.F), nil
+}
+
+// Get the message of a struct, variant or tuple
+func (pg *ProtoGenerator) definedMessage(gend typegen.GeneratedType) (*message, error) {
+	mt := gend.MType()
+	m := &message{name: gend.DisplayName(), rustPath: strings.Join(utils.PathStrs(mt.Type.Path), "::")}
+
+	switch g := gend.(type) {
+	case *typegen.CompositeGend:
+		for i, gf := range g.Fields {
+			if err := pg.addField(m, snakeCase(gf.Name), i+1, mt.Type.Def.Composite.Fields[i].Type.Int64()); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	case *typegen.VariantGend:
+		m.oneof = true
+		for _, v := range mt.Type.Def.Variant.Variants {
+			f := field{name: snakeCase(utils.AsName(string(v.Name))), number: int(v.Index) + 1}
+			switch len(v.Fields) {
+			case 0:
+				f.typ = emptyName
+			case 1:
+				gend, err := pg.generated(v.Fields[0].Type.Int64())
+				if err != nil {
+					return nil, err
+				}
+				// A oneof can't have repeated cases
+				f.typ, _, err = pg.protoType(gend, true)
+				if err != nil {
+					return nil, fmt.Errorf("variant %v: %v", v.Name, err)
+				}
+			default:
+				fields := &message{name: variantFieldsName(string(v.Name))}
+				for j, vf := range v.Fields {
+					name := fmt.Sprintf("field%v", j)
+					if vf.HasName {
+						name = snakeCase(utils.AsName(string(vf.Name)))
+					}
+					if err := pg.addField(fields, name, j+1, vf.Type.Int64()); err != nil {
+						return nil, fmt.Errorf("variant %v: %v", v.Name, err)
+					}
+				}
+				m.nested = append(m.nested, fields)
+				f.typ = fields.name
+			}
+			if f.name == oneofName {
+				return nil, fmt.Errorf("variant %v has the name of the oneof", v.Name)
+			}
+			if err := addUnique(m, f); err != nil {
+				return nil, err
+			}
+		}
+		return m, nil
+	}
+
+	// The only other defined types are tuples, whose fields are Elem0, Elem1...
+	if !mt.Type.Def.IsTuple {
+		return nil, fmt.Errorf("unexpected defined type %v", gend.DisplayName())
+	}
+	for i, id := range mt.Type.Def.Tuple {
+		if err := pg.addField(m, fmt.Sprintf("elem%v", i), i+1, id.Int64()); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Add a field of a type id to a message
+func (pg *ProtoGenerator) addField(m *message, name string, number int, id int64) error {
+	gend, err := pg.generated(id)
+	if err != nil {
+		return err
+	}
+	typ, repeated, err := pg.protoType(gend, false)
+	if err != nil {
+		return fmt.Errorf("field %v: %v", name, err)
+	}
+	return addUnique(m, field{name: name, number: number, typ: typ, repeated: repeated})
+}
+
+// Add a field to a message, which fails if it has a field with the same name
+func addUnique(m *message, f field) error {
+	for _, other := range m.fields {
+		if other.name == f.name {
+			return fmt.Errorf("two fields are named %v", f.name)
+		}
+	}
+	m.fields = append(m.fields, f)
+	return nil
+}
+
+// Get the generated type of a type id, which must have been generated already
+func (pg *ProtoGenerator) generated(id int64) (typegen.GeneratedType, error) {
+	gend, ok := pg.tygen.Generated(id)
+	if !ok {
+		return nil, fmt.Errorf("type id %v was never generated", id)
+	}
+	return gend, nil
+}
+
+// Get the protobuf type of a generated type, and whether it's a repeated field. If `singular`, lists
+// are wrapped in a message instead of being repeated.
+func (pg *ProtoGenerator) protoType(gend typegen.GeneratedType, singular bool) (string, bool, error) {
+	switch g := gend.(type) {
+	case *typegen.PrimitiveGend:
+		typ, ok := map[string]string{
+			"bool":     "bool",
+			"string":   "string",
+			"byte":     "uint32",
+			"uint16":   "uint32",
+			"uint32":   "uint32",
+			"uint64":   "uint64",
+			"int8":     "sint32",
+			"int16":    "sint32",
+			"int32":    "sint32",
+			"rune":     "sint32",
+			"int64":    "sint64",
+			"struct{}": emptyName,
+		}[g.PrimName]
+		if !ok {
+			return "", false, fmt.Errorf("unexpected primitive %v", g.PrimName)
+		}
+		return typ, false, nil
+	case *typegen.ArrayGend:
+		return pg.listType(g, g.Inner, singular)
+	case *typegen.SliceGend:
+		return pg.listType(g, g.Inner, singular)
+	case *typegen.CompositeGend:
+		return g.Name, false, nil
+	case *typegen.VariantGend:
+		return g.Name, false, nil
+	case *typegen.Gend:
+		if g.Pkg == pg.tygen.PkgPath {
+			return g.Name, false, nil
+		}
+		switch g.Name {
+		case "U128", "U256", "I128", "I256", "UCompact":
+			return "string", false, nil
+		}
+	}
+	return "", false, fmt.Errorf("unexpected generated type %v", gend.DisplayName())
+}
+
+// Get the protobuf type of a slice or array. Lists of bytes are bytes, and other lists are repeated
+// fields of their items, or a message wrapping them if `singular`, e.g.
+//
+//	message Uint32Slice {
+//		repeated uint32 items = 1;
+//	}
+func (pg *ProtoGenerator) listType(list typegen.GeneratedType, inner typegen.GeneratedType, singular bool) (string, bool, error) {
+	if isByte(inner) {
+		return "bytes", false, nil
+	}
+	typ, _, err := pg.protoType(inner, true)
+	if err != nil {
+		return "", false, err
+	}
+	if !singular {
+		return typ, true, nil
+	}
+	name := list.DisplayName()
+	pg.wrappers[name] = &message{name: name, fields: []field{{name: "items", number: 1, typ: typ, repeated: true}}}
+	return name, false, nil
+}
+
+func isByte(gend typegen.GeneratedType) bool {
+	p, ok := gend.(*typegen.PrimitiveGend)
+	return ok && p.PrimName == "byte"
+}
+
+// The name of the message nested in a variant's message, holding the fields of a variant with
+// several fields
+func variantFieldsName(variant string) string {
+	return utils.AsName(variant, "Fields")
+}
+
+// Render the .proto file, with the defined types in order of name, then the list wrappers.
+//
+// example (shortened) output:
+//
+//	syntax = "proto3";
+//
+//	package example_com.chain.types;
+//
+//	option go_package = "example.com/chain/typespb";
+//
+//	// Generated from pallet_balances::AccountData
+//	message AccountData {
+//	  string free = 1;
+//	  ...
+//	}
+//
+//	// Generated from pallet_kinds::Status
+//	message Status {
+//	  oneof variant {
+//	    Empty active = 1;
+//	    MovedFields moved = 2;
+//	  }
+//
+//	  message MovedFields {
+//	    ...
+//	  }
+//	}
+func (pg *ProtoGenerator) protoFile() string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "// The messages of the types generated into %v, for the converters in protoconv.\n", pg.tygen.PkgPath)
+	fmt.Fprintf(b, "// Generate their go code from the generated package's directory with\n//\n")
+	fmt.Fprintf(b, "//	protoc --go_out=paths=source_relative:. %v\n", ProtoFile)
+	fmt.Fprintf(b, "syntax = \"proto3\";\n\npackage %v;\n\noption go_package = %q;\n", pg.protoPkg, pg.pbPath)
+	fmt.Fprintf(b, "\n// An empty value, of variants without fields and of ()\nmessage %v {}\n", emptyName)
+
+	names := []string{}
+	for name := range pg.defined {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeMessage(b, pg.defined[name], "")
+	}
+	names = []string{}
+	for name := range pg.wrappers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeMessage(b, pg.wrappers[name], "")
+	}
+	return b.String()
+}
+
+// Write a message, and the messages nested in it
+func writeMessage(b *strings.Builder, m *message, indent string) {
+	b.WriteString("\n")
+	if m.rustPath != "" {
+		fmt.Fprintf(b, "%v// Generated from %v\n", indent, m.rustPath)
+	}
+	if len(m.fields) == 0 {
+		fmt.Fprintf(b, "%vmessage %v {}\n", indent, m.name)
+		return
+	}
+	fmt.Fprintf(b, "%vmessage %v {\n", indent, m.name)
+	fieldIndent := indent + "  "
+	if m.oneof {
+		fmt.Fprintf(b, "%v  oneof %v {\n", indent, oneofName)
+		fieldIndent += "  "
+	}
+	for _, f := range m.fields {
+		repeated := ""
+		if f.repeated {
+			repeated = "repeated "
+		}
+		fmt.Fprintf(b, "%v%v%v %v = %v;\n", fieldIndent, repeated, f.typ, f.name, f.number)
+	}
+	if m.oneof {
+		fmt.Fprintf(b, "%v  }\n", indent)
+	}
+	for _, nested := range m.nested {
+		writeMessage(b, nested, indent+"  ")
+	}
+	fmt.Fprintf(b, "%v}\n", indent)
+}
+
+// Make a protobuf identifier of a segment of a package path, e.g. example.com -> example_com
+func protoIdent(s string) string {
+	res := []rune{}
+	for _, r := range strings.ToLower(s) {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			r = '_'
+		}
+		res = append(res, r)
+	}
+	if len(res) == 0 || unicode.IsDigit(res[0]) {
+		res = append([]rune{'_'}, res...)
+	}
+	return string(res)
+}
+
+// Turn a camelcased go name into a snake cased protobuf field name, e.g. PaysFee -> pays_fee
+func snakeCase(name string) string {
+	res := []rune{}
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+			res = append(res, '_')
+		}
+		res = append(res, unicode.ToLower(r))
+	}
+	return string(res)
+}
@@ -0,0 +1,70 @@
+package protogen
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/metadata"
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateKinds(t *testing.T) {
+	inp, err := os.ReadFile("../testdata/fixtures/kinds.json")
+	require.NoError(t, err)
+	meta, encMeta, err := metadata.ParseMetadata(inp)
+	require.NoError(t, err)
+	tg := typegen.NewTypeGenerator(meta, encMeta, "example.com/kinds/types")
+	for _, ty := range meta.Lookup.Types {
+		_, err := tg.GetType(ty.ID.Int64())
+		require.NoError(t, err)
+	}
+
+	pg := NewProtoGenerator("example.com/kinds", &tg)
+	proto, conv, err := pg.Generate()
+	require.NoError(t, err)
+	require.Contains(t, proto, "package example_com.kinds.types;")
+	require.Contains(t, proto, "message Empty {}")
+	require.False(t, strings.Contains(conv, "%!v(PANIC="), "Generated code contains errors")
+}
+
+func TestSnakeCase(t *testing.T) {
+	for name, want := range map[string]string{
+		"PaysFee":      "pays_fee",
+		"Free":         "free",
+		"AccountId32":  "account_id32",
+		"Elem0":        "elem0",
+		"BoundedVecU8": "bounded_vec_u8",
+		"ID":           "id",
+	} {
+		require.Equal(t, want, snakeCase(name), name)
+	}
+}
+
+// The names protoc-gen-go gives fields
+func TestGoCamelCase(t *testing.T) {
+	for name, want := range map[string]string{
+		"pays_fee":    "PaysFee",
+		"elem0":       "Elem0",
+		"field_0":     "Field_0",
+		"_private":    "XPrivate",
+		"foo.bar":     "FooBar",
+		"Foo.Bar":     "Foo_Bar",
+		"extrinsic_1": "Extrinsic_1",
+	} {
+		require.Equal(t, want, goCamelCase(name), name)
+	}
+}
+
+// Fields whose names conflict with the methods of a message get a suffix
+func TestGoNames(t *testing.T) {
+	m := &message{name: "Call", oneof: true, fields: []field{
+		{name: "reset", number: 1, typ: emptyName},
+		{name: "string", number: 2, typ: emptyName},
+		{name: "remark", number: 3, typ: "bytes"},
+	}}
+	names, oneof := goNames(m)
+	require.Equal(t, map[string]string{"reset": "Reset_", "string": "String_", "remark": "Remark"}, names)
+	require.Equal(t, "Variant", oneof)
+}
@@ -0,0 +1,336 @@
+package schemagen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/aphoh/go-substrate-gen/typegen"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/hash"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+)
+
+// The schema generator describes the JSON encoding of the generated types, as a JSON Schema
+// document or as the components of an OpenAPI document, so services in other languages can check
+// the JSON the go code produces.
+//
+// The schemas follow the go encoding: structs and tuples are objects keyed by their go field names,
+// variants are the string "Type::Variant" without fields, or an object with that key otherwise,
+// byte slices are base64 strings and byte arrays are arrays of numbers. Like the doc generator, it
+// must run once the code is generated, and only describes types the TypeGenerator already generated.
+type SchemaGenerator struct {
+	meta  *types.MetadataV14
+	tygen *typegen.TypeGenerator
+}
+
+// The JSON Schema dialect of both documents. OpenAPI 3.1 schemas are JSON Schema 2020-12.
+const dialect = "https://json-schema.org/draft/2020-12/schema"
+
+func NewSchemaGenerator(meta *types.MetadataV14, tygen *typegen.TypeGenerator) SchemaGenerator {
+	return SchemaGenerator{meta: meta, tygen: tygen}
+}
+
+// A JSON schema, with the keywords the generator uses
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Const                string             `json:"const,omitempty"`
+	Minimum              json.Number        `json:"minimum,omitempty"`
+	Maximum              json.Number        `json:"maximum,omitempty"`
+	ContentEncoding      string             `json:"contentEncoding,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+}
+
+// Generate a JSON Schema document, defining every generated type in $defs.
+//
+// example (shortened) output:
+//
+//	{
+//	  "$schema": "https://json-schema.org/draft/2020-12/schema",
+//	  "title": "example.com/chain/types",
+//	  "$defs": {
+//	    "AccountData": {
+//	      "type": "object",
+//	      "properties": {"Free": {"type": "integer", "minimum": 0}},
+//	      ...
+//	    }
+//	  }
+//	}
+func (sg *SchemaGenerator) JSONSchema() ([]byte, error) {
+	defs, err := sg.definitions("#/$defs/")
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(struct {
+		Schema      string             `json:"$schema"`
+		Title       string             `json:"title"`
+		Description string             `json:"description"`
+		Defs        map[string]*Schema `json:"$defs"`
+	}{dialect, sg.tygen.PkgPath, sg.description(), defs}, "", "  ")
+}
+
+// Generate an OpenAPI 3.1 document without paths, defining every generated type in its components.
+// Its version is the blake2-256 hash of the metadata, so the document changes version whenever the
+// runtime does.
+//
+// example (shortened) output:
+//
+//	{
+//	  "openapi": "3.1.0",
+//	  "info": {"title": "example.com/chain/types", "version": "0x1c2d..."},
+//	  "components": {
+//	    "schemas": {
+//	      "AccountData": {...}
+//	    }
+//	  }
+//	}
+func (sg *SchemaGenerator) OpenAPI() ([]byte, error) {
+	defs, err := sg.definitions("#/components/schemas/")
+	if err != nil {
+		return nil, err
+	}
+	enc, err := codec.Encode(sg.meta)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding metadata: %v", err)
+	}
+	h, err := hash.NewBlake2b256(nil)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(enc)
+
+	type info struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		Version     string `json:"version"`
+	}
+	type components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	}
+	return json.MarshalIndent(struct {
+		OpenAPI           string     `json:"openapi"`
+		Info              info       `json:"info"`
+		JsonSchemaDialect string     `json:"jsonSchemaDialect"`
+		Components        components `json:"components"`
+	}{
+		OpenAPI:           "3.1.0",
+		Info:              info{sg.tygen.PkgPath, sg.description(), codec.HexEncodeToString(h.Sum(nil))},
+		JsonSchemaDialect: dialect,
+		Components:        components{defs},
+	}, "", "  ")
+}
+
+func (sg *SchemaGenerator) description() string {
+	return fmt.Sprintf("The JSON encoding of the types generated into %v, as produced by encoding/json", sg.tygen.PkgPath)
+}
+
+// Get the schema of every defined type, by name. References to the definitions start with `ref`.
+func (sg *SchemaGenerator) definitions(ref string) (map[string]*Schema, error) {
+	defs := map[string]*Schema{}
+	for name, gend := range sg.tygen.DefinedTypes() {
+		s, err := sg.definition(gend, ref)
+		if err != nil {
+			return nil, fmt.Errorf("type %v: %v", name, err)
+		}
+		if path := utils.PathStrs(gend.MType().Type.Path); len(path) > 0 {
+			s.Description = fmt.Sprintf("Generated from the rust type %v", strings.Join(path, "::"))
+		}
+		defs[name] = s
+	}
+	return defs, nil
+}
+
+// Get the schema of the definition of a struct, variant or tuple
+func (sg *SchemaGenerator) definition(gend typegen.GeneratedType, ref string) (*Schema, error) {
+	switch g := gend.(type) {
+	case *typegen.CompositeGend:
+		ids := []int64{}
+		for _, f := range g.MTy.Type.Def.Composite.Fields {
+			ids = append(ids, f.Type.Int64())
+		}
+		return sg.object(g.Fields, ids, ref)
+	case *typegen.VariantGend:
+		return sg.variant(g, ref)
+	}
+
+	// The only other defined types are tuples, whose fields are Elem0, Elem1...
+	def := gend.MType().Type.Def
+	if !def.IsTuple {
+		return nil, fmt.Errorf("unexpected defined type %v", gend.DisplayName())
+	}
+	fields := []typegen.GenField{}
+	ids := []int64{}
+	for i, id := range def.Tuple {
+		fields = append(fields, typegen.GenField{Name: utils.AsName("Elem", fmt.Sprint(i))})
+		ids = append(ids, id.Int64())
+	}
+	return sg.object(fields, ids, ref)
+}
+
+// Get the schema of a go struct, with the given fields of the given type ids. Go marshals every
+// field, by name.
+func (sg *SchemaGenerator) object(fields []typegen.GenField, ids []int64, ref string) (*Schema, error) {
+	s := &Schema{Type: "object", Properties: map[string]*Schema{}, Required: []string{}, AdditionalProperties: ptr(false)}
+	for i, f := range fields {
+		fs, err := sg.typeSchema(ids[i], ref)
+		if err != nil {
+			return nil, fmt.Errorf("field %v: %v", f.Name, err)
+		}
+		s.Properties[f.Name] = fs
+		s.Required = append(s.Required, f.Name)
+	}
+	if len(fields) == 0 {
+		s.MaxProperties = ptr(0)
+	}
+	return s, nil
+}
+
+// Get the schema of a variant, which is one of the JSON its generated MarshalJSON produces for
+// each variant.
+//
+// example output, for a variant with no fields, one field, and two fields:
+//
+//	{"oneOf": [
+//		{"const": "Status::Active"},
+//		{"type": "object", "properties": {"Status::Frozen": {"type": "integer"}}, ...},
+//		{"type": "object", "properties": {"Status::Moved": {
+//			"type": "object", "properties": {"AsMovedFrom0": ..., "AsMovedTo1": ...}, ...
+//		}}, ...}
+//	]}
+func (sg *SchemaGenerator) variant(g *typegen.VariantGend, ref string) (*Schema, error) {
+	s := &Schema{OneOf: []*Schema{}}
+	for i, v := range g.MTy.Type.Def.Variant.Variants {
+		fullName := fmt.Sprintf("%s::%s", g.Name, v.Name)
+		ids := []int64{}
+		for _, f := range v.Fields {
+			ids = append(ids, f.Type.Int64())
+		}
+
+		var value *Schema
+		var err error
+		switch len(v.Fields) {
+		case 0:
+			s.OneOf = append(s.OneOf, &Schema{Const: fullName})
+			continue
+		case 1:
+			value, err = sg.typeSchema(ids[0], ref)
+		default:
+			value, err = sg.object(g.AsVarFields[i], ids, ref)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("variant %v: %v", v.Name, err)
+		}
+		s.OneOf = append(s.OneOf, &Schema{
+			Type:                 "object",
+			Properties:           map[string]*Schema{fullName: value},
+			Required:             []string{fullName},
+			AdditionalProperties: ptr(false),
+		})
+	}
+	return s, nil
+}
+
+// Get the schema of the go type generated for a type id, referring to definitions with `ref`
+func (sg *SchemaGenerator) typeSchema(id int64, ref string) (*Schema, error) {
+	gend, ok := sg.tygen.Generated(id)
+	if !ok {
+		return nil, fmt.Errorf("type id %v was never generated", id)
+	}
+
+	switch g := gend.(type) {
+	case *typegen.PrimitiveGend:
+		return primitiveSchema(g.PrimName)
+	case *typegen.ArrayGend:
+		items, err := sg.typeSchema(g.MTy.Type.Def.Array.Type.Int64(), ref)
+		if err != nil {
+			return nil, err
+		}
+		// Unlike byte slices, byte arrays are marshalled as arrays of numbers
+		return &Schema{Type: "array", Items: items, MinItems: ptr(g.Len), MaxItems: ptr(g.Len)}, nil
+	case *typegen.SliceGend:
+		if inner, ok := g.Inner.(*typegen.PrimitiveGend); ok && inner.PrimName == "byte" {
+			return &Schema{Type: "string", ContentEncoding: "base64"}, nil
+		}
+		innerId := g.MTy.Type.Def.Sequence.Type.Int64()
+		if g.MTy.Type.Def.IsBitSequence {
+			innerId = g.MTy.Type.Def.BitSequence.BitStoreType.Int64()
+		}
+		items, err := sg.typeSchema(innerId, ref)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case *typegen.CompositeGend:
+		return &Schema{Ref: ref + g.Name}, nil
+	case *typegen.VariantGend:
+		return &Schema{Ref: ref + g.Name}, nil
+	case *typegen.Gend:
+		if g.Pkg == sg.tygen.PkgPath {
+			return &Schema{Ref: ref + g.Name}, nil
+		}
+		return ctypeSchema(g.Name)
+	}
+	return nil, fmt.Errorf("unexpected generated type %v", gend.DisplayName())
+}
+
+// Get the schema of a go primitive
+func primitiveSchema(name string) (*Schema, error) {
+	integer := func(min, max string) (*Schema, error) {
+		return &Schema{Type: "integer", Minimum: json.Number(min), Maximum: json.Number(max)}, nil
+	}
+	switch name {
+	case "bool":
+		return &Schema{Type: "boolean"}, nil
+	case "string":
+		return &Schema{Type: "string"}, nil
+	case "struct{}":
+		return &Schema{Type: "object", MaxProperties: ptr(0)}, nil
+	case "byte":
+		return integer("0", fmt.Sprint(math.MaxUint8))
+	case "uint16":
+		return integer("0", fmt.Sprint(math.MaxUint16))
+	case "uint32":
+		return integer("0", fmt.Sprint(math.MaxUint32))
+	case "uint64":
+		return integer("0", fmt.Sprint(uint64(math.MaxUint64)))
+	case "int8":
+		return integer(fmt.Sprint(math.MinInt8), fmt.Sprint(math.MaxInt8))
+	case "int16":
+		return integer(fmt.Sprint(math.MinInt16), fmt.Sprint(math.MaxInt16))
+	case "int32", "rune":
+		return integer(fmt.Sprint(math.MinInt32), fmt.Sprint(math.MaxInt32))
+	case "int64":
+		return integer(fmt.Sprint(math.MinInt64), fmt.Sprint(math.MaxInt64))
+	}
+	return nil, fmt.Errorf("unexpected primitive %v", name)
+}
+
+// Get the schema of a go-substrate-rpc-client type. The big integers marshal as JSON numbers, which
+// may not fit in a float64. UCompact is a big.Int without its methods, so it marshals as an empty
+// object, losing its value.
+func ctypeSchema(name string) (*Schema, error) {
+	switch name {
+	case "U128", "U256":
+		return &Schema{Type: "integer", Minimum: "0"}, nil
+	case "I128", "I256":
+		return &Schema{Type: "integer"}, nil
+	case "UCompact":
+		return &Schema{Type: "object", MaxProperties: ptr(0), Description: "types.UCompact marshals without its value"}, nil
+	}
+	return nil, fmt.Errorf("unexpected type types.%v", name)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
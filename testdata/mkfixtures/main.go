@@ -0,0 +1,235 @@
+// Command mkfixtures writes the small synthetic metadata fixtures used by the tests, into
+// testdata/fixtures. Run it from the repository root with
+//
+//	go run ./testdata/mkfixtures
+//
+// The fixtures are:
+//
+//	minimal.json   a runtime with only a System pallet
+//	kinds.json     a runtime with a Kinds pallet using every kind of type and every storage hasher
+//	wrappers.json  a runtime with the Utility, Sudo, Proxy and Multisig pallets, whose calls wrap calls
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aphoh/go-substrate-gen/metadata/builder"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+func main() {
+	for _, name := range []string{"minimal", "kinds", "wrappers"} {
+		b := newRuntime(name)
+		if err := write(filepath.Join("testdata", "fixtures", name+".json"), b); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func write(fp string, b *builder.Builder) error {
+	out, err := b.JSON()
+	if err != nil {
+		return fmt.Errorf("error building %v: %v", fp, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(fp), os.ModePerm); err != nil {
+		return err
+	}
+	return os.WriteFile(fp, append(out, '\n'), 0644)
+}
+
+// A field with the Rust type name it's declared with
+func field(name string, ty builder.TypeId, typeName string) builder.Field {
+	return builder.Field{Name: name, Type: ty, TypeName: typeName}
+}
+
+// Build the runtime of a fixture, which has a System pallet and the pallets named by the fixture
+func newRuntime(name string) *builder.Builder {
+	b := builder.New("fixture_runtime")
+
+	u8 := b.Primitive(types.IsU8)
+	u32 := b.Primitive(types.IsU32)
+	u64 := b.Primitive(types.IsU64)
+	unit := b.Tuple()
+	bytes := b.Sequence(u8)
+	h256 := b.Composite("primitive_types::H256", field("", b.Array(32, u8), "[u8; 32]"))
+	accountId := b.Composite("sp_core::crypto::AccountId32", field("", b.Array(32, u8), "[u8; 32]"))
+
+	// Extrinsics
+	address := b.Variant("sp_runtime::multiaddress::MultiAddress",
+		builder.V("Id", 0, field("", accountId, "AccountId")),
+		builder.V("Index", 1, field("", b.Compact(unit), "AccountIndex")),
+		builder.V("Raw", 2, field("", bytes, "Vec<u8>")),
+	)
+	sig := b.Array(64, u8)
+	signature := b.Variant("sp_runtime::MultiSignature",
+		builder.V("Ed25519", 0, field("", b.Composite("sp_core::ed25519::Signature", field("", sig, "[u8; 64]")), "ed25519::Signature")),
+		builder.V("Sr25519", 1, field("", b.Composite("sp_core::sr25519::Signature", field("", sig, "[u8; 64]")), "sr25519::Signature")),
+	)
+	b.Extrinsic(address, signature).
+		SignedExtension("CheckSpecVersion", b.Composite("frame_system::extensions::check_spec_version::CheckSpecVersion"), u32).
+		SignedExtension("CheckGenesis", b.Composite("frame_system::extensions::check_genesis::CheckGenesis"), h256).
+		SignedExtension("CheckNonce", b.Composite("frame_system::extensions::check_nonce::CheckNonce", field("", b.Compact(u32), "T::Index")), unit)
+
+	// System
+	dispatchInfo := b.Composite("frame_support::dispatch::DispatchInfo",
+		field("weight", u64, "Weight"),
+		field("class", b.Variant("frame_support::dispatch::DispatchClass", builder.V("Normal", 0), builder.V("Operational", 1), builder.V("Mandatory", 2)), "DispatchClass"),
+		field("pays_fee", b.Variant("frame_support::dispatch::Pays", builder.V("Yes", 0), builder.V("No", 1)), "Pays"),
+	)
+	eventRecord := b.Composite("frame_system::EventRecord",
+		field("event", b.RuntimeEvent(), "E"),
+		field("topics", b.Sequence(h256), "Vec<T>"),
+	)
+	system := b.Pallet("System", 0).Crate("frame_system").
+		Call("remark", field("remark", bytes, "Vec<u8>")).
+		Event("ExtrinsicSuccess", field("dispatch_info", dispatchInfo, "DispatchInfo")).
+		Event("Remarked", field("sender", accountId, "T::AccountId"), field("hash", h256, "T::Hash")).
+		Error("CallFiltered", " The origin filter prevents the call")
+	system.Map("BlockHash", u32, h256, builder.Twox64Concat)
+	system.Value("Events", b.Sequence(eventRecord)).Default([]byte{})
+
+	switch name {
+	case "kinds":
+		kinds(b, accountId)
+	case "wrappers":
+		wrappers(b, accountId, address)
+	}
+	return b
+}
+
+// Add the Kinds pallet, which uses every kind of type and every storage hasher
+func kinds(b *builder.Builder, accountId builder.TypeId) {
+	prims := []builder.Field{}
+	for _, p := range []struct {
+		name string
+		prim types.Si0TypeDefPrimitive
+	}{
+		{"bool", types.IsBool}, {"char", types.IsChar}, {"str", types.IsStr},
+		{"u8", types.IsU8}, {"u16", types.IsU16}, {"u32", types.IsU32}, {"u64", types.IsU64}, {"u128", types.IsU128}, {"u256", types.IsU256},
+		{"i8", types.IsI8}, {"i16", types.IsI16}, {"i32", types.IsI32}, {"i64", types.IsI64}, {"i128", types.IsI128}, {"i256", types.IsI256},
+	} {
+		prims = append(prims, field("a_"+p.name, b.Primitive(p.prim), p.name))
+	}
+	primitives := b.Composite("pallet_kinds::Primitives", prims...)
+
+	u8 := b.Primitive(types.IsU8)
+	u16 := b.Primitive(types.IsU16)
+	u32 := b.Primitive(types.IsU32)
+	u64 := b.Primitive(types.IsU64)
+	u128 := b.Primitive(types.IsU128)
+	bytes := b.Sequence(u8)
+	accountData := b.Composite("pallet_kinds::AccountData",
+		field("free", u128, "Balance"),
+		field("reserved", u128, "Balance"),
+		field("flags", u32, "u32"),
+	)
+	perbill := b.Composite("sp_arithmetic::per_things::Perbill", field("", u32, "u32"))
+	status := b.Variant("pallet_kinds::Status",
+		builder.V("Active", 0),
+		builder.V("Inactive", 1),
+		builder.V("Frozen", 2, field("until", u32, "BlockNumber"), field("reason", bytes, "Vec<u8>")),
+		builder.V("Slashed", 3, field("", perbill, "Perbill")),
+	)
+	// A recursive type
+	tree := b.Type("pallet_kinds::Tree")
+	tree.Variant(
+		builder.V("Leaf", 0, field("", u32, "u32")),
+		builder.V("Node", 1, field("", b.Sequence(tree.Id()), "Vec<Tree>")),
+	)
+	bits := b.BitSequence(u8, b.Composite("bitvec::order::Lsb0"))
+	triple := b.Tuple(u8, u16, u32)
+
+	kinds := b.Pallet("Kinds", 1).
+		Call("all_kinds",
+			field("primitives", primitives, "Primitives"),
+			field("status", status, "Status"),
+			field("maybe", b.Option(u32), "Option<u32>"),
+			field("accounts", b.Sequence(accountData), "Vec<AccountData>"),
+			field("fixed", b.Array(4, u32), "[u32; 4]"),
+			field("pair", b.Tuple(u32, u64), "(u32, u64)"),
+			field("triple", triple, "(u8, u16, u32)"),
+			field("single", b.Tuple(u32), "(u32,)"),
+			field("nothing", b.Tuple(), "()"),
+			field("small", b.Compact(u32), "Compact<u32>"),
+			field("big", b.Compact(u128), "Compact<Balance>"),
+			field("bits", bits, "BitVec<u8, Lsb0>"),
+			field("tree", tree.Id(), "Tree"),
+		).
+		Call("dispatch", field("call", b.RuntimeCall(), "Box<<T as Config>::RuntimeCall>")).
+		Call("unused", field("never", b.Variant("pallet_kinds::Never"), "Never")).
+		Event("Happened", field("who", accountId, "T::AccountId"), field("amount", u128, "Balance")).
+		Event("StatusChanged", field("", status, "Status")).
+		Error("TooMany", " There are too many items").
+		Constant("MaxItems", u32, uint32(16), " The most items of a list")
+
+	kinds.Value("Counter", u32).Default(uint32(0))
+	kinds.Value("Account", accountData)
+	kinds.Map("Blake2128", u32, u64, builder.Blake2_128)
+	kinds.Map("Blake2256", u32, u64, builder.Blake2_256)
+	kinds.Map("Blake2128Concat", accountId, accountData, builder.Blake2_128Concat)
+	kinds.Map("Twox128", u32, u32, builder.Twox128)
+	kinds.Map("Twox256", u32, u32, builder.Twox256)
+	kinds.Map("Twox64Concat", u64, status, builder.Twox64Concat)
+	kinds.Map("Identity", u32, bytes, builder.Identity)
+	kinds.Map("Defaulted", u32, u32, builder.Twox64Concat).Default(uint32(7))
+	kinds.Map("DoubleMap", b.Tuple(accountId, u32), b.Option(u32), builder.Blake2_128Concat, builder.Twox64Concat)
+	kinds.Map("NMap", triple, u128, builder.Blake2_128Concat, builder.Twox64Concat, builder.Identity)
+}
+
+// Add the Utility, Sudo, Proxy and Multisig pallets, whose calls wrap other calls, with the
+// arguments of the FRAME pallets
+func wrappers(b *builder.Builder, accountId, address builder.TypeId) {
+	u16 := b.Primitive(types.IsU16)
+	u32 := b.Primitive(types.IsU32)
+	u64 := b.Primitive(types.IsU64)
+	boolean := b.Primitive(types.IsBool)
+	call := b.RuntimeCall()
+	calls := b.Sequence(call)
+	boxed := "Box<<T as Config>::RuntimeCall>"
+
+	b.Pallet("Utility", 1).
+		Call("batch", field("calls", calls, "Vec<<T as Config>::RuntimeCall>")).
+		Call("as_derivative", field("index", u16, "u16"), field("call", call, boxed)).
+		Call("batch_all", field("calls", calls, "Vec<<T as Config>::RuntimeCall>")).
+		Call("force_batch", field("calls", calls, "Vec<<T as Config>::RuntimeCall>"))
+
+	b.Pallet("Sudo", 2).
+		Call("sudo", field("call", call, boxed)).
+		Call("sudo_unchecked_weight", field("call", call, boxed), field("weight", u64, "Weight")).
+		Call("sudo_as", field("who", address, "AccountIdLookupOf<T>"), field("call", call, boxed))
+
+	proxyType := b.Option(b.Variant("fixture_runtime::ProxyType", builder.V("Any", 0), builder.V("NonTransfer", 1)))
+	b.Pallet("Proxy", 3).
+		Call("proxy",
+			field("real", address, "AccountIdLookupOf<T>"),
+			field("force_proxy_type", proxyType, "Option<T::ProxyType>"),
+			field("call", call, boxed),
+		).
+		Call("proxy_announced",
+			field("delegate", address, "AccountIdLookupOf<T>"),
+			field("real", address, "AccountIdLookupOf<T>"),
+			field("force_proxy_type", proxyType, "Option<T::ProxyType>"),
+			field("call", call, boxed),
+		)
+
+	// Before it was removed, as_multi took the call in a WrapperKeepOpaque, prefixed by its length
+	opaque := b.Type("frame_support::traits::misc::WrapperKeepOpaque").Params(builder.Param{Name: "T", Type: call})
+	opaque.Composite(field("", b.Compact(u32), "Compact<u32>"), field("", call, "T"))
+	timepoint := b.Composite("pallet_multisig::Timepoint", field("height", u32, "BlockNumber"), field("index", u32, "u32"))
+	b.Pallet("Multisig", 4).
+		Call("as_multi_threshold_1",
+			field("other_signatories", b.Sequence(accountId), "Vec<T::AccountId>"),
+			field("call", call, boxed),
+		).
+		Call("as_multi",
+			field("threshold", u16, "u16"),
+			field("other_signatories", b.Sequence(accountId), "Vec<T::AccountId>"),
+			field("maybe_timepoint", b.Option(timepoint), "Option<Timepoint<T::BlockNumber>>"),
+			field("call", opaque.Id(), "OpaqueCall<T>"),
+			field("store_call", boolean, "bool"),
+			field("max_weight", u64, "Weight"),
+		)
+}
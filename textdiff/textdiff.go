@@ -0,0 +1,186 @@
+// Package textdiff renders line-based unified diffs, used to show which generated files are out of
+// date.
+package textdiff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The number of unchanged lines shown around each change
+const context = 3
+
+// Above this many cells, the changed middle of two texts isn't diffed line by line, and is shown
+// as removed then added instead
+const maxLcsCells = 1 << 22
+
+// An edit turning one text into another: a line which is kept (' '), removed ('-') or added ('+').
+// Lines keep their newline, so a last line without one differs from the same line with one.
+type edit struct {
+	op   byte
+	line string
+}
+
+// Get the unified diff between texts a and b, named aName and bName in the header, or "" if they're
+// equal. A last line without a newline is marked like diff marks it, with "\ No newline at end of
+// file".
+//
+// example output:
+//
+//	--- types/types.go
+//	+++ types/types.go
+//	@@ -10,7 +10,7 @@
+//	 ...
+//	-	Free types.U128
+//	+	Free types.U64
+//	 ...
+func Unified(aName, bName, a, b string) string {
+	if a == b {
+		return ""
+	}
+	edits := diffLines(splitLines(a), splitLines(b))
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %v\n+++ %v\n", aName, bName)
+	// The number of lines of a and b before each edit
+	aBefore := make([]int, len(edits)+1)
+	bBefore := make([]int, len(edits)+1)
+	for i, e := range edits {
+		aBefore[i+1], bBefore[i+1] = aBefore[i], bBefore[i]
+		if e.op != '+' {
+			aBefore[i+1]++
+		}
+		if e.op != '-' {
+			bBefore[i+1]++
+		}
+	}
+
+	for i := 0; i < len(edits); {
+		if edits[i].op == ' ' {
+			i++
+			continue
+		}
+		// A hunk runs from the context before this change to the context after the last change
+		// which is close enough to be merged with it
+		start := max(0, i-context)
+		end := i
+		for j := i; j < len(edits) && j <= end+2*context; j++ {
+			if edits[j].op != ' ' {
+				end = j
+			}
+		}
+		end = min(len(edits), end+context+1)
+
+		aCount, bCount := aBefore[end]-aBefore[start], bBefore[end]-bBefore[start]
+		aStart, bStart := aBefore[start]+1, bBefore[start]+1
+		if aCount == 0 {
+			aStart--
+		}
+		if bCount == 0 {
+			bStart--
+		}
+		fmt.Fprintf(&sb, "@@ -%v,%v +%v,%v @@\n", aStart, aCount, bStart, bCount)
+		for _, e := range edits[start:end] {
+			sb.WriteByte(e.op)
+			sb.WriteString(e.line)
+			if !strings.HasSuffix(e.line, "\n") {
+				sb.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = end
+	}
+	return sb.String()
+}
+
+// Split a text into lines, keeping their newlines
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// Get the edits turning a into b, keeping their longest common subsequence of lines
+func diffLines(a, b []string) []edit {
+	// Lines shared at the start and end are kept, which leaves only the changed middle to diff
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	edits := []edit{}
+	for _, l := range a[:prefix] {
+		edits = append(edits, edit{' ', l})
+	}
+	edits = append(edits, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, l := range a[len(a)-suffix:] {
+		edits = append(edits, edit{' ', l})
+	}
+	return edits
+}
+
+func diffMiddle(a, b []string) []edit {
+	edits := []edit{}
+	if len(a)*len(b) > maxLcsCells {
+		for _, l := range a {
+			edits = append(edits, edit{'-', l})
+		}
+		for _, l := range b {
+			edits = append(edits, edit{'+', l})
+		}
+		return edits
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			edits = append(edits, edit{' ', a[i]})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			edits = append(edits, edit{'-', a[i]})
+			i++
+		default:
+			edits = append(edits, edit{'+', b[j]})
+			j++
+		}
+	}
+	return edits
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,61 @@
+package textdiff
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnified(t *testing.T) {
+	require.Equal(t, "", Unified("a", "b", "x\ny\n", "x\ny\n"))
+
+	a := strings.Join([]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16"}, "\n") + "\n"
+	b := strings.Join([]string{"1", "2", "3", "4", "five", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17"}, "\n") + "\n"
+	require.Equal(t, `--- old
++++ new
+@@ -2,7 +2,7 @@
+ 2
+ 3
+ 4
+-5
++five
+ 6
+ 7
+ 8
+@@ -14,3 +14,4 @@
+ 14
+ 15
+ 16
++17
+`, Unified("old", "new", a, b))
+
+	// Missing files diff against nothing
+	require.Equal(t, `--- /dev/null
++++ new
+@@ -0,0 +1,2 @@
++1
++2
+`, Unified("/dev/null", "new", "", "1\n2\n"))
+	// Nearby changes share a hunk
+	require.Equal(t, `--- old
++++ new
+@@ -1,5 +1,5 @@
+-1
++one
+ 2
+ 3
+ 4
+-5
++five
+`, Unified("old", "new", "1\n2\n3\n4\n5\n", "one\n2\n3\n4\nfive\n"))
+
+	// A missing newline at the end is a change
+	require.Equal(t, `--- old
++++ new
+@@ -1,1 +1,1 @@
+-x
+\ No newline at end of file
++x
+`, Unified("old", "new", "x", "x\n"))
+}
@@ -0,0 +1,278 @@
+package typegen
+
+import (
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/dave/jennifer/jen"
+)
+
+// Names of the generated interfaces used by the storage functions to talk to a node. Without
+// context support, the reader and querier are subsets of go-substrate-rpc-client's `state.State`, so
+// it can be passed directly, and `StateSubscriber` wraps it as a subscriber. Tests or other
+// transports can provide their own implementations instead.
+const (
+	StorageReaderName     = "StorageReader"
+	StorageQuerierName    = "StorageQuerier"
+	StorageSubscriberName = "StorageSubscriber"
+)
+
+// Generate the interfaces the generated storage functions depend on. Without context support,
+// also assert that go-substrate-rpc-client's `state.State` implements the reader and querier, and
+// generate a `StateSubscriber` adapting its subscriptions to the subscriber. With context
+// support, every method takes a context as its first argument, and a `ContextState` implementing
+// them on top of a go-substrate-rpc-client connection is generated as well.
+//
+// example output:
+//
+//	// Reads a single storage value at a block hash or at the latest block.
+//	type StorageReader interface {
+//		GetStorage(key types.StorageKey, target interface{}, blockHash types.Hash) (ok bool, err error)
+//		GetStorageLatest(key types.StorageKey, target interface{}) (ok bool, err error)
+//	}
+//	...
+//	var _ StorageReader = state.State(nil)
+//	...
+//	type StateSubscriber struct {
+//		State state.State
+//	}
+func (tg *TypeGenerator) GenerateBackend() {
+	f := tg.F
+	key := jen.Id("key").Qual(utils.CTYPES, "StorageKey")
+	keys := jen.Id("keys").Index().Qual(utils.CTYPES, "StorageKey")
+	target := jen.Id("target").Interface()
+	blockHash := jen.Id("blockHash").Qual(utils.CTYPES, "Hash")
+	okErr := jen.List(jen.Id("ok").Bool(), jen.Err().Error())
+	changeSets := jen.List(jen.Index().Qual(utils.CTYPES, "StorageChangeSet"), jen.Error())
+
+	// Prepend the context to the params of each method if needed
+	params := func(ps ...jen.Code) *jen.Statement {
+		if tg.WithContext {
+			ps = append([]jen.Code{jen.Id("ctx").Qual("context", "Context")}, ps...)
+		}
+		return jen.Params(ps...)
+	}
+
+	f.Comment("Reads a single storage value at a block hash or at the latest block.")
+	f.Type().Id(StorageReaderName).Interface(
+		jen.Id("GetStorage").Add(params(key.Clone(), target.Clone(), blockHash.Clone())).Params(okErr.Clone()),
+		jen.Id("GetStorageLatest").Add(params(key.Clone(), target.Clone())).Params(okErr.Clone()),
+	)
+
+	f.Comment("Queries the values of many storage keys at once, at a single block or over a range of blocks.")
+	f.Type().Id(StorageQuerierName).Interface(
+		jen.Id("QueryStorageAt").Add(params(keys.Clone(), jen.Id("block").Qual(utils.CTYPES, "Hash"))).Params(changeSets.Clone()),
+		jen.Id("QueryStorageAtLatest").Add(params(keys.Clone())).Params(changeSets.Clone()),
+		jen.Id("QueryStorage").Add(params(keys.Clone(), jen.Id("startBlock").Qual(utils.CTYPES, "Hash"), jen.Id("block").Qual(utils.CTYPES, "Hash"))).Params(changeSets.Clone()),
+		jen.Id("QueryStorageLatest").Add(params(keys.Clone(), jen.Id("startBlock").Qual(utils.CTYPES, "Hash"))).Params(changeSets.Clone()),
+	)
+
+	// go-substrate-rpc-client's subscriptions can't be constructed elsewhere, so the subscriber
+	// returns an interface which they implement, and tests can fake
+	f.Comment("A subscription to changes of storage keys, implemented by go-substrate-rpc-client's `*state.StorageSubscription`.")
+	f.Type().Id("StorageSubscription").Interface(
+		jen.Id("Chan").Params().Op("<-").Chan().Qual(utils.CTYPES, "StorageChangeSet"),
+		jen.Id("Err").Params().Op("<-").Chan().Error(),
+		jen.Id("Unsubscribe").Params(),
+	)
+	f.Var().Id("_").Id("StorageSubscription").Op("=").Op("&").Qual(utils.GSRPCState, "StorageSubscription").Values()
+
+	f.Comment("Subscribes to changes of storage keys.")
+	f.Type().Id(StorageSubscriberName).Interface(
+		jen.Id("SubscribeStorageRaw").Add(params(keys.Clone())).Params(jen.Id("StorageSubscription"), jen.Error()),
+	)
+
+	if !tg.WithContext {
+		for _, name := range []string{StorageReaderName, StorageQuerierName} {
+			f.Var().Id("_").Id(name).Op("=").Qual(utils.GSRPCState, "State").Call(jen.Nil())
+		}
+		tg.genStateSubscriber()
+		return
+	}
+
+	tg.genContextState()
+}
+
+// Generate `StateSubscriber`, which implements the subscriber with go-substrate-rpc-client's
+// `state.State`, whose SubscribeStorageRaw returns the concrete subscription.
+//
+// example output:
+//
+//	type StateSubscriber struct {
+//		State state.State
+//	}
+//
+//	func (s StateSubscriber) SubscribeStorageRaw(keys []types.StorageKey) (StorageSubscription, error) {
+//		sub, err := s.State.SubscribeStorageRaw(keys)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return sub, nil
+//	}
+func (tg *TypeGenerator) genStateSubscriber() {
+	f := tg.F
+	f.Comment("Implements StorageSubscriber with a go-substrate-rpc-client `state.State`, e.g.")
+	f.Comment("`StateSubscriber{State: api.RPC.State}`")
+	f.Type().Id("StateSubscriber").Struct(jen.Id("State").Qual(utils.GSRPCState, "State"))
+	f.Var().Id("_").Id(StorageSubscriberName).Op("=").Id("StateSubscriber").Values()
+
+	f.Func().Params(jen.Id("s").Id("StateSubscriber")).Id("SubscribeStorageRaw").Params(
+		jen.Id("keys").Index().Qual(utils.CTYPES, "StorageKey"),
+	).Params(jen.Id("StorageSubscription"), jen.Error()).BlockFunc(func(g *jen.Group) {
+		g.List(jen.Id("sub"), jen.Err()).Op(":=").Id("s").Dot("State").Dot("SubscribeStorageRaw").Call(jen.Id("keys"))
+		// A nil *state.StorageSubscription isn't a nil interface
+		utils.ErrorCheckWithNil(g)
+		g.Return(jen.Id("sub"), jen.Nil())
+	})
+}
+
+// Generate `ContextState`, which implements the context-aware storage interfaces by making json-rpc
+// calls through a go-substrate-rpc-client connection that honor the context.
+//
+// example output (shortened):
+//
+//	// Makes json-rpc calls and subscriptions that honor a context. The client returned by
+//	// go-substrate-rpc-client's `client.Connect` implements it.
+//	type ContextCaller interface {
+//		CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+//		Subscribe(ctx context.Context, namespace, ...) (*gethrpc.ClientSubscription, error)
+//	}
+//
+//	type ContextState struct {
+//		Client ContextCaller
+//	}
+//
+//	func NewContextState(c client.Client) (ContextState, error) {...}
+//
+//	func (s ContextState) GetStorage(ctx context.Context, key types.StorageKey, target interface{}, blockHash types.Hash) (ok bool, err error) {
+//		return s.getStorage(ctx, key, target, &blockHash)
+//	}
+//	...
+func (tg *TypeGenerator) genContextState() {
+	f := tg.F
+	ctx := func() *jen.Statement { return jen.Id("ctx").Qual("context", "Context") }
+	keys := func() *jen.Statement { return jen.Id("keys").Index().Qual(utils.CTYPES, "StorageKey") }
+	hash := func(name string) *jen.Statement { return jen.Id(name).Qual(utils.CTYPES, "Hash") }
+	changeSets := func() *jen.Statement {
+		return jen.List(jen.Index().Qual(utils.CTYPES, "StorageChangeSet"), jen.Error())
+	}
+	recv := func() *jen.Statement { return jen.Func().Params(jen.Id("s").Id("ContextState")) }
+
+	f.Comment("Makes json-rpc calls and subscriptions that honor a context. The client returned by")
+	f.Comment("go-substrate-rpc-client's `client.Connect` implements it.")
+	f.Type().Id("ContextCaller").Interface(
+		jen.Id("CallContext").Params(ctx(), jen.Id("result").Interface(), jen.Id("method").String(), jen.Id("args").Op("...").Interface()).Error(),
+		jen.Id("Subscribe").Params(
+			ctx(),
+			jen.List(jen.Id("namespace"), jen.Id("subscribeMethodSuffix"), jen.Id("unsubscribeMethodSuffix"), jen.Id("notificationMethodSuffix")).String(),
+			jen.Id("channel").Interface(),
+			jen.Id("args").Op("...").Interface(),
+		).Params(jen.Op("*").Qual(utils.GETHRPC, "ClientSubscription"), jen.Error()),
+	)
+
+	f.Comment("Implements the storage interfaces using a go-substrate-rpc-client connection, passing the")
+	f.Comment("context of each call down to the json-rpc layer.")
+	f.Type().Id("ContextState").Struct(jen.Id("Client").Id("ContextCaller"))
+	for _, name := range []string{StorageReaderName, StorageQuerierName, StorageSubscriberName} {
+		f.Var().Id("_").Id(name).Op("=").Id("ContextState").Values()
+	}
+
+	f.Comment("Create a ContextState from a client returned by go-substrate-rpc-client's `client.Connect`")
+	f.Func().Id("NewContextState").Params(jen.Id("c").Qual(utils.GSRPCClient, "Client")).Params(jen.Id("ContextState"), jen.Error()).Block(
+		jen.List(jen.Id("caller"), jen.Id("ok")).Op(":=").Id("c").Assert(jen.Id("ContextCaller")),
+		jen.If(jen.Op("!").Id("ok")).Block(
+			jen.Return(jen.Id("ContextState").Values(), jen.Qual("fmt", "Errorf").Call(jen.Lit("client %T does not support calls with a context"), jen.Id("c"))),
+		),
+		jen.Return(jen.Id("ContextState").Values(jen.Dict{jen.Id("Client"): jen.Id("caller")}), jen.Nil()),
+	)
+
+	// Appends the block hash to the arguments when there is one, like go-substrate-rpc-client's
+	// `client.CallWithBlockHash`
+	f.Add(recv()).Id("call").Params(
+		ctx(), jen.Id("result").Interface(), jen.Id("method").String(), jen.Id("blockHash").Op("*").Qual(utils.CTYPES, "Hash"), jen.Id("args").Op("...").Interface(),
+	).Error().Block(
+		jen.If(jen.Id("blockHash").Op("!=").Nil()).Block(
+			jen.Id("args").Op("=").Append(jen.Id("args"), jen.Id("blockHash").Dot("Hex").Call()),
+		),
+		jen.Return(jen.Id("s").Dot("Client").Dot("CallContext").Call(jen.Id("ctx"), jen.Id("result"), jen.Id("method"), jen.Id("args").Op("..."))),
+	)
+
+	f.Add(recv()).Id("getStorage").Params(
+		ctx(), jen.Id("key").Qual(utils.CTYPES, "StorageKey"), jen.Id("target").Interface(), jen.Id("blockHash").Op("*").Qual(utils.CTYPES, "Hash"),
+	).Params(jen.Id("ok").Bool(), jen.Err().Error()).BlockFunc(func(g *jen.Group) {
+		g.Var().Id("res").String()
+		g.Err().Op("=").Id("s").Dot("call").Call(jen.Id("ctx"), jen.Op("&").Id("res"), jen.Lit("state_getStorage"), jen.Id("blockHash"), jen.Id("key").Dot("Hex").Call())
+		utils.ErrorCheckWithNamedArgs(g)
+		g.List(jen.Id("bz"), jen.Err()).Op(":=").Qual(utils.CCODEC, "HexDecodeString").Call(jen.Id("res"))
+		utils.ErrorCheckWithNamedArgs(g)
+		g.If(jen.Len(jen.Id("bz")).Op("==").Lit(0)).Block(jen.Return(jen.False(), jen.Nil()))
+		g.Return(jen.True(), jen.Qual(utils.CCODEC, "Decode").Call(jen.Id("bz"), jen.Id("target")))
+	})
+
+	f.Add(recv()).Id("GetStorage").Params(
+		ctx(), jen.Id("key").Qual(utils.CTYPES, "StorageKey"), jen.Id("target").Interface(), hash("blockHash"),
+	).Params(jen.Id("ok").Bool(), jen.Err().Error()).Block(
+		jen.Return(jen.Id("s").Dot("getStorage").Call(jen.Id("ctx"), jen.Id("key"), jen.Id("target"), jen.Op("&").Id("blockHash"))),
+	)
+	f.Add(recv()).Id("GetStorageLatest").Params(
+		ctx(), jen.Id("key").Qual(utils.CTYPES, "StorageKey"), jen.Id("target").Interface(),
+	).Params(jen.Id("ok").Bool(), jen.Err().Error()).Block(
+		jen.Return(jen.Id("s").Dot("getStorage").Call(jen.Id("ctx"), jen.Id("key"), jen.Id("target"), jen.Nil())),
+	)
+
+	// Turn storage keys into the hex strings expected by the json-rpc methods
+	f.Func().Id("hexKeys").Params(keys()).Index().String().Block(
+		jen.Id("res").Op(":=").Make(jen.Index().String(), jen.Len(jen.Id("keys"))),
+		jen.For(jen.List(jen.Id("i"), jen.Id("key")).Op(":=").Range().Id("keys")).Block(
+			jen.Id("res").Index(jen.Id("i")).Op("=").Id("key").Dot("Hex").Call(),
+		),
+		jen.Return(jen.Id("res")),
+	)
+
+	f.Add(recv()).Id("queryStorage").Params(
+		ctx(), jen.Id("method").String(), jen.Id("blockHash").Op("*").Qual(utils.CTYPES, "Hash"), jen.Id("args").Op("...").Interface(),
+	).Params(changeSets()).Block(
+		jen.Var().Id("res").Index().Qual(utils.CTYPES, "StorageChangeSet"),
+		jen.Err().Op(":=").Id("s").Dot("call").Call(jen.Id("ctx"), jen.Op("&").Id("res"), jen.Id("method"), jen.Id("blockHash"), jen.Id("args").Op("...")),
+		jen.Return(jen.Id("res"), jen.Err()),
+	)
+	f.Add(recv()).Id("QueryStorageAt").Params(ctx(), keys(), hash("block")).Params(changeSets()).Block(
+		jen.Return(jen.Id("s").Dot("queryStorage").Call(jen.Id("ctx"), jen.Lit("state_queryStorageAt"), jen.Op("&").Id("block"), jen.Id("hexKeys").Call(jen.Id("keys")))),
+	)
+	f.Add(recv()).Id("QueryStorageAtLatest").Params(ctx(), keys()).Params(changeSets()).Block(
+		jen.Return(jen.Id("s").Dot("queryStorage").Call(jen.Id("ctx"), jen.Lit("state_queryStorageAt"), jen.Nil(), jen.Id("hexKeys").Call(jen.Id("keys")))),
+	)
+	f.Add(recv()).Id("QueryStorage").Params(ctx(), keys(), hash("startBlock"), hash("block")).Params(changeSets()).Block(
+		jen.Return(jen.Id("s").Dot("queryStorage").Call(jen.Id("ctx"), jen.Lit("state_queryStorage"), jen.Op("&").Id("block"), jen.Id("hexKeys").Call(jen.Id("keys")), jen.Id("startBlock").Dot("Hex").Call())),
+	)
+	f.Add(recv()).Id("QueryStorageLatest").Params(ctx(), keys(), hash("startBlock")).Params(changeSets()).Block(
+		jen.Return(jen.Id("s").Dot("queryStorage").Call(jen.Id("ctx"), jen.Lit("state_queryStorage"), jen.Nil(), jen.Id("hexKeys").Call(jen.Id("keys")), jen.Id("startBlock").Dot("Hex").Call())),
+	)
+
+	// The subscription returned by ContextState
+	f.Type().Id("contextSubscription").Struct(
+		jen.Id("sub").Op("*").Qual(utils.GETHRPC, "ClientSubscription"),
+		jen.Id("channel").Chan().Qual(utils.CTYPES, "StorageChangeSet"),
+	)
+	f.Func().Params(jen.Id("s").Op("*").Id("contextSubscription")).Id("Chan").Params().Op("<-").Chan().Qual(utils.CTYPES, "StorageChangeSet").Block(
+		jen.Return(jen.Id("s").Dot("channel")),
+	)
+	f.Func().Params(jen.Id("s").Op("*").Id("contextSubscription")).Id("Err").Params().Op("<-").Chan().Error().Block(
+		jen.Return(jen.Id("s").Dot("sub").Dot("Err").Call()),
+	)
+	f.Func().Params(jen.Id("s").Op("*").Id("contextSubscription")).Id("Unsubscribe").Params().Block(
+		jen.Id("s").Dot("sub").Dot("Unsubscribe").Call(),
+	)
+
+	f.Add(recv()).Id("SubscribeStorageRaw").Params(ctx(), keys()).Params(jen.Id("StorageSubscription"), jen.Error()).BlockFunc(func(g *jen.Group) {
+		g.Id("c").Op(":=").Make(jen.Chan().Qual(utils.CTYPES, "StorageChangeSet"))
+		g.List(jen.Id("sub"), jen.Err()).Op(":=").Id("s").Dot("Client").Dot("Subscribe").Call(
+			jen.Id("ctx"), jen.Lit("state"), jen.Lit("subscribeStorage"), jen.Lit("unsubscribeStorage"), jen.Lit("storage"), jen.Id("c"), jen.Id("hexKeys").Call(jen.Id("keys")),
+		)
+		utils.ErrorCheckWithNil(g)
+		g.Return(jen.Op("&").Id("contextSubscription").Values(jen.Dict{jen.Id("sub"): jen.Id("sub"), jen.Id("channel"): jen.Id("c")}), jen.Nil())
+	})
+}
+
+// Get a jen statement for one of the backend interfaces generated by GenerateBackend
+func (tg *TypeGenerator) BackendCode(name string) *jen.Statement {
+	return jen.Qual(tg.PkgPath, name)
+}
@@ -39,7 +39,8 @@ func (tg *TypeGenerator) GetCallType() (*VariantGend, error) {
 }
 
 // Generate a function named `AsCall` on the chain runtime calls that converts the chain runtime
-// calls into go-substrate-rpc calls by codec-encoding our call.
+// calls into go-substrate-rpc calls by codec-encoding our call. It's deprecated, since nothing else
+// generated needs go-substrate-rpc's call type, and EncodeCallData encodes the call without it.
 //
 // example output:
 //
@@ -66,6 +67,10 @@ func (tg *TypeGenerator) GenerateCallHelpers() error {
 
 	// The follow three lines output:
 	//func (c *RuntimeCall) AsCall() (ctypes.Call, error) {}
+	tg.F.Comment("Convert the call into a go-substrate-rpc-client call, for its extrinsic types.")
+	tg.F.Comment("")
+	tg.F.Comment("Deprecated: the generated extrinsic builder takes the call itself, and EncodeCallData encodes it")
+	tg.F.Comment("without depending on go-substrate-rpc-client's types.")
 	tg.F.Func().Parens(
 		jen.Id("c").Op("*").Custom(utils.TypeOpts, callGend.Code()),
 	).Id("AsCall").Call().Parens(jen.List(jen.Id("ret").Qual(utils.CTYPES, "Call"), jen.Err().Error())).BlockFunc(func(g1 *jen.Group) {
@@ -96,12 +101,282 @@ func (tg *TypeGenerator) GenerateCallHelpers() error {
 		})
 		g1.Return()
 	})
+	tg.callGenCallData(callGend)
+	return tg.callGenIntrospection(callGend)
+}
+
+// Generate helpers to encode and decode call data, the SCALE-encoded call used in governance
+// proposals and multisig approvals, and to hash it.
+//
+// example output:
+//
+//	func (c *RuntimeCall) EncodeCallData() ([]byte, error) {
+//		return codec.Encode(c)
+//	}
+//
+//	func (c *RuntimeCall) CallHash() (ret [32]byte, err error) {
+//		data, err := c.EncodeCallData()
+//		if err != nil {
+//			return
+//		}
+//		h, err := hash.NewBlake2b256(nil)
+//		if err != nil {
+//			return
+//		}
+//		h.Write(data)
+//		copy(ret[:], h.Sum(nil))
+//		return
+//	}
+//
+//	func DecodeCallData(data []byte) (ret RuntimeCall, err error) {
+//		err = codec.Decode(data, &ret)
+//		return
+//	}
+func (tg *TypeGenerator) callGenCallData(callGend *VariantGend) {
+	tg.F.Comment("Encode the call data of the call")
+	tg.F.Func().Params(
+		jen.Id("c").Op("*").Custom(utils.TypeOpts, callGend.Code()),
+	).Id("EncodeCallData").Params().Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.Return(jen.Qual(utils.CCODEC, "Encode").Call(jen.Id("c"))),
+	)
+
+	tg.F.Comment("Get the blake2-256 hash of the call data of the call")
+	tg.F.Func().Params(
+		jen.Id("c").Op("*").Custom(utils.TypeOpts, callGend.Code()),
+	).Id("CallHash").Params().Params(jen.Id("ret").Index(jen.Lit(32)).Byte(), jen.Err().Error()).BlockFunc(func(g *jen.Group) {
+		g.List(jen.Id("data"), jen.Err()).Op(":=").Id("c").Dot("EncodeCallData").Call()
+		utils.ErrorCheckWithNamedArgs(g)
+		g.List(jen.Id("h"), jen.Err()).Op(":=").Qual(utils.GSRPCHash, "NewBlake2b256").Call(jen.Nil())
+		utils.ErrorCheckWithNamedArgs(g)
+		g.Id("h").Dot("Write").Call(jen.Id("data"))
+		g.Copy(jen.Id("ret").Index(jen.Empty().Op(":")), jen.Id("h").Dot("Sum").Call(jen.Nil()))
+		g.Return()
+	})
+
+	tg.F.Comment(fmt.Sprintf("Decode call data into a %v", callGend.Name))
+	tg.F.Func().Id("DecodeCallData").Params(jen.Id("data").Index().Byte()).Params(
+		jen.Id("ret").Custom(utils.TypeOpts, callGend.Code()), jen.Err().Error(),
+	).Block(
+		jen.Err().Op("=").Qual(utils.CCODEC, "Decode").Call(jen.Id("data"), jen.Op("&").Id("ret")),
+		jen.Return(),
+	)
+}
+
+// Generate introspection methods on the runtime call, which tell which pallet and call it holds,
+// and a registry of the metadata of every call, keyed by call index.
+//
+// example (shortened) output:
+//
+//	func (c *RuntimeCall) PalletName() string {
+//		if c.IsSystem {
+//			return "System"
+//		}
+//		return ""
+//	}
+//
+//	func (c *RuntimeCall) PalletIndex() (uint8, bool) {
+//		if c.IsSystem {
+//			return 0, true
+//		}
+//		return 0, false
+//	}
+//
+//	func (c *RuntimeCall) CallName() string {
+//		if c.IsSystem && c.AsSystemField0 != nil {
+//			if c.AsSystemField0.IsRemark {
+//				return "remark"
+//			}
+//		}
+//		return ""
+//	}
+//
+//	func (c *RuntimeCall) Args() map[string]any {
+//		if c.IsSystem && c.AsSystemField0 != nil {
+//			if c.AsSystemField0.IsRemark {
+//				return map[string]any{"remark": c.AsSystemField0.AsRemarkRemark0}
+//			}
+//		}
+//		return nil
+//	}
+//
+//	var callRegistry = map[types.CallIndex]CallMeta{
+//		{SectionIndex: 0, MethodIndex: 1}: {
+//			Pallet: "System",
+//			Name:   "remark",
+//			Docs:   []string{"Make some on-chain remark."},
+//			Args:   []CallArgMeta{{Name: "remark", TypeName: "Vec<u8>", TypeId: 10}},
+//		},
+//	}
+func (tg *TypeGenerator) callGenIntrospection(callGend *VariantGend) error {
+	palletVariants := callGend.MType().Type.Def.Variant.Variants
+
+	// Each pallet's calls are a variant embedded in the runtime call
+	palletCalls := make([]*VariantGend, len(palletVariants))
+	for i, variant := range palletVariants {
+		if len(variant.Fields) != 1 {
+			continue
+		}
+		inner, err := tg.GetType(variant.Fields[0].Type.Int64())
+		if err != nil {
+			return err
+		}
+		if v, ok := inner.(*VariantGend); ok {
+			palletCalls[i] = v
+		}
+	}
+
+	// Generate a method which returns the value from `palletRet` for the pallet the call is in
+	genPalletMethod := func(name, comment string, ret, zero jen.Code, palletRet func(i int) jen.Code) {
+		tg.F.Comment(comment)
+		tg.F.Func().Params(
+			jen.Id("c").Op("*").Custom(utils.TypeOpts, callGend.Code()),
+		).Id(name).Params().Add(ret).BlockFunc(func(g1 *jen.Group) {
+			for i := range palletVariants {
+				g1.If(jen.Id("c").Dot(callGend.IsVarFields[i].Name)).Block(jen.Return(palletRet(i)))
+			}
+			g1.Return(zero)
+		})
+	}
+	// Generate a method which returns the value from `callRet` for the call it holds. `pallet` is
+	// the expression for the call's pallet call variant
+	genCallMethod := func(name, comment string, ret, zero jen.Code, callRet func(pallet func() *jen.Statement, i, j int) jen.Code) {
+		tg.F.Comment(comment)
+		tg.F.Func().Params(
+			jen.Id("c").Op("*").Custom(utils.TypeOpts, callGend.Code()),
+		).Id(name).Params().Add(ret).BlockFunc(func(g1 *jen.Group) {
+			for i := range palletVariants {
+				inner := palletCalls[i]
+				if inner == nil {
+					continue
+				}
+				asField := callGend.AsVarFields[i][0]
+				pallet := func() *jen.Statement { return jen.Id("c").Dot(asField.Name) }
+				cond := jen.Id("c").Dot(callGend.IsVarFields[i].Name)
+				if asField.IsPtr {
+					cond = cond.Op("&&").Add(pallet()).Op("!=").Nil()
+				}
+				g1.If(cond).BlockFunc(func(g2 *jen.Group) {
+					for j := range inner.MType().Type.Def.Variant.Variants {
+						g2.If(pallet().Dot(inner.IsVarFields[j].Name)).Block(jen.Return(callRet(pallet, i, j)))
+					}
+				})
+			}
+			g1.Return(zero)
+		})
+	}
+	callVariant := func(i, j int) types.Si1Variant {
+		return palletCalls[i].MType().Type.Def.Variant.Variants[j]
+	}
+
+	genPalletMethod("PalletName", "Get the name of the pallet of the call", jen.String(), jen.Lit(""), func(i int) jen.Code {
+		return jen.Lit(string(palletVariants[i].Name))
+	})
+	// 0 is a valid index, so a missing pallet or call is reported separately
+	genPalletMethod("PalletIndex", "Get the index of the pallet of the call, and whether a pallet is set", jen.Parens(jen.List(jen.Uint8(), jen.Bool())), jen.List(jen.Lit(0), jen.False()), func(i int) jen.Code {
+		return jen.List(jen.Lit(int(callGend.Indices[i])), jen.True())
+	})
+	genCallMethod("CallName", "Get the name of the call within its pallet", jen.String(), jen.Lit(""), func(_ func() *jen.Statement, i, j int) jen.Code {
+		return jen.Lit(string(callVariant(i, j).Name))
+	})
+	genCallMethod("CallIndex", "Get the index of the call within its pallet, and whether a call is set", jen.Parens(jen.List(jen.Uint8(), jen.Bool())), jen.List(jen.Lit(0), jen.False()), func(_ func() *jen.Statement, i, j int) jen.Code {
+		return jen.List(jen.Lit(int(palletCalls[i].Indices[j])), jen.True())
+	})
+	genCallMethod("Args", "Get the arguments of the call, keyed by their names in the metadata", jen.Map(jen.String()).Any(), jen.Nil(), func(pallet func() *jen.Statement, i, j int) jen.Code {
+		return jen.Map(jen.String()).Any().ValuesFunc(func(g *jen.Group) {
+			for k, f := range callVariant(i, j).Fields {
+				g.Lit(argName(f, k)).Op(":").Add(pallet()).Dot(palletCalls[i].AsVarFields[j][k].Name)
+			}
+		})
+	})
+
+	// The call registry
+	metaName := tg.uniqueName("CallMeta")
+	argMetaName := tg.uniqueName("CallArgMeta")
+	tg.F.Comment("The metadata of a call, as found in the call registry")
+	tg.F.Type().Id(metaName).Struct(
+		jen.Id("Pallet").String(),
+		jen.Id("Name").String(),
+		jen.Id("Docs").Index().String(),
+		jen.Id("Args").Index().Id(argMetaName),
+	)
+	tg.F.Comment("The metadata of a call argument")
+	tg.F.Type().Id(argMetaName).Struct(
+		jen.Comment("The name of the argument, or its position if it has no name"),
+		jen.Id("Name").String(),
+		jen.Comment("The name of the argument's rust type"),
+		jen.Id("TypeName").String(),
+		jen.Comment("The id of the argument's type in the metadata"),
+		jen.Id("TypeId").Int64(),
+	)
+
+	tg.F.Var().Id("callRegistry").Op("=").Map(jen.Qual(utils.CTYPES, "CallIndex")).Id(metaName).ValuesFunc(func(g1 *jen.Group) {
+		for i, pv := range palletVariants {
+			if palletCalls[i] == nil {
+				continue
+			}
+			for j, cv := range palletCalls[i].MType().Type.Def.Variant.Variants {
+				g1.Line().Values(
+					jen.Id("SectionIndex").Op(":").Lit(int(callGend.Indices[i])),
+					jen.Id("MethodIndex").Op(":").Lit(int(palletCalls[i].Indices[j])),
+				).Op(":").ValuesFunc(func(g2 *jen.Group) {
+					g2.Line().Id("Pallet").Op(":").Lit(string(pv.Name))
+					g2.Line().Id("Name").Op(":").Lit(string(cv.Name))
+					g2.Line().Id("Docs").Op(":").Index().String().ValuesFunc(func(g3 *jen.Group) {
+						for _, d := range cv.Docs {
+							g3.Lit(string(d))
+						}
+					})
+					g2.Line().Id("Args").Op(":").Index().Id(argMetaName).ValuesFunc(func(g3 *jen.Group) {
+						for k, f := range cv.Fields {
+							g3.Values(
+								jen.Id("Name").Op(":").Lit(argName(f, k)),
+								jen.Id("TypeName").Op(":").Lit(string(f.TypeName)),
+								jen.Id("TypeId").Op(":").Lit(int(f.Type.Int64())),
+							)
+						}
+					})
+					g2.Line()
+				})
+			}
+		}
+		g1.Line()
+	})
+
+	tg.F.Comment("Look up the metadata of the call with the given index")
+	tg.F.Func().Id("LookupCall").Params(jen.Id("index").Qual(utils.CTYPES, "CallIndex")).Params(jen.Id(metaName), jen.Bool()).Block(
+		jen.List(jen.Id("meta"), jen.Id("ok")).Op(":=").Id("callRegistry").Index(jen.Id("index")),
+		jen.Return(jen.Id("meta"), jen.Id("ok")),
+	)
+
+	tg.F.Comment("Get the metadata of the call from the call registry, if a call is set")
+	tg.F.Func().Params(
+		jen.Id("c").Op("*").Custom(utils.TypeOpts, callGend.Code()),
+	).Id("Meta").Params().Params(jen.Id(metaName), jen.Bool()).Block(
+		jen.List(jen.Id("pallet"), jen.Id("ok")).Op(":=").Id("c").Dot("PalletIndex").Call(),
+		jen.If(jen.Op("!").Id("ok")).Block(jen.Return(jen.Id(metaName).Values(), jen.False())),
+		jen.List(jen.Id("call"), jen.Id("ok")).Op(":=").Id("c").Dot("CallIndex").Call(),
+		jen.If(jen.Op("!").Id("ok")).Block(jen.Return(jen.Id(metaName).Values(), jen.False())),
+		jen.Return(jen.Id("LookupCall").Call(jen.Qual(utils.CTYPES, "CallIndex").Values(
+			jen.Id("SectionIndex").Op(":").Id("pallet"),
+			jen.Id("MethodIndex").Op(":").Id("call"),
+		))),
+	)
 	return nil
 }
 
-// Get the index of the runtime's call type within the metadata's type array
+// The name of a call argument, which is its position if it's unnamed
+func argName(f types.Si1Field, position int) string {
+	if f.Name != "" {
+		return string(f.Name)
+	}
+	return fmt.Sprint(position)
+}
+
+// Get the index of the runtime's call type within the metadata's type array. Types are searched in
+// order of id, so the same call type is found every time
 func getCallTypeId(mtypes map[int64]types.PortableTypeV14) (int64, error) {
-	for tyId, ty := range mtypes {
+	for _, tyId := range sortedIds(mtypes) {
+		ty := mtypes[tyId]
 		if len(ty.Type.Path) >= 2 {
 			p0 := string(ty.Type.Path[0])
 			p1 := string(ty.Type.Path[1])
@@ -0,0 +1,490 @@
+package typegen
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/aphoh/go-substrate-gen/metahash"
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/dave/jennifer/jen"
+)
+
+// Generate the structural hashes of every call, storage entry and event in the metadata, and a
+// `CheckCompatibility` function which compares them with a live node's metadata. This lets
+// services fail fast, or disable the pallets which changed, after a runtime upgrade. The live
+// metadata is hashed by a copy of the `metahash` package's hasher, so generated code doesn't
+// depend on the generator.
+//
+// example (shortened) output:
+//
+//	var metadataHashes = map[string]palletHashes{"System": {
+//		Calls:   map[string]string{"remark": "0x8e4f..."},
+//		Storage: map[string]string{"Account": "0x1c2d..."},
+//		Events:  map[string]string{"Remarked": "0x55aa..."},
+//	}}
+//
+//	func CheckCompatibility(live *types.Metadata) (CompatibilityReport, error) {
+//		if live.Version != 14 {
+//			return nil, fmt.Errorf("unsupported metadata version: %v, only v14 is currently supported", live.Version)
+//		}
+//		liveHashes, err := hashMetadata(&live.AsMetadataV14)
+//		...
+//	}
+func (tg *TypeGenerator) GenerateCompatibility(meta *types.MetadataV14) error {
+	hashes, err := metahash.Hash(meta)
+	if err != nil {
+		return err
+	}
+
+	hashMap := func(m map[string]string) jen.Code {
+		names := []string{}
+		for name := range m {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return jen.Map(jen.String()).String().ValuesFunc(func(g *jen.Group) {
+			for _, name := range names {
+				g.Line().Lit(name).Op(":").Lit(m[name])
+			}
+			g.Line()
+		})
+	}
+
+	tg.F.Comment("The structural hashes of a pallet's calls, storage entries and events, keyed by name")
+	tg.F.Type().Id("palletHashes").Struct(
+		jen.Id("Calls").Map(jen.String()).String(),
+		jen.Id("Storage").Map(jen.String()).String(),
+		jen.Id("Events").Map(jen.String()).String(),
+	)
+
+	tg.F.Comment("Structural hashes of the calls, storage entries and events in the metadata this code was generated from")
+	tg.F.Var().Id("metadataHashes").Op("=").Map(jen.String()).Id("palletHashes").ValuesFunc(func(g *jen.Group) {
+		for _, pallet := range meta.Pallets {
+			ph := hashes[string(pallet.Name)]
+			g.Line().Lit(string(pallet.Name)).Op(":").Values(
+				jen.Line().Id("Calls").Op(":").Add(hashMap(ph.Calls)),
+				jen.Line().Id("Storage").Op(":").Add(hashMap(ph.Storage)),
+				jen.Line().Id("Events").Op(":").Add(hashMap(ph.Events)),
+				jen.Line(),
+			)
+		}
+		g.Line()
+	})
+
+	tg.compatGenReport()
+	tg.compatGenCheck()
+	tg.compatGenHasher()
+	return nil
+}
+
+// Generate the report of which pallets and items are compatible
+//
+// example (shortened) output:
+//
+//	type PalletCompatibility struct {
+//		// Whether the pallet is in the live metadata
+//		Present bool
+//		Calls   map[string]bool
+//		Storage map[string]bool
+//		Events  map[string]bool
+//	}
+//
+//	type CompatibilityReport map[string]PalletCompatibility
+//
+//	func (r CompatibilityReport) Incompatible() []string {...}
+func (tg *TypeGenerator) compatGenReport() {
+	items := func(p string) []jen.Code {
+		return []jen.Code{jen.Id(p).Dot("Calls"), jen.Id(p).Dot("Storage"), jen.Id(p).Dot("Events")}
+	}
+
+	tg.F.Comment("Whether each call, storage entry and event of a pallet is compatible with the live metadata,")
+	tg.F.Comment("keyed by name")
+	tg.F.Type().Id("PalletCompatibility").Struct(
+		jen.Comment("Whether the pallet is in the live metadata"),
+		jen.Id("Present").Bool(),
+		jen.Id("Calls").Map(jen.String()).Bool(),
+		jen.Id("Storage").Map(jen.String()).Bool(),
+		jen.Id("Events").Map(jen.String()).Bool(),
+	)
+
+	tg.F.Comment("The compatibility of every pallet the code was generated for, keyed by pallet name")
+	tg.F.Type().Id("CompatibilityReport").Map(jen.String()).Id("PalletCompatibility")
+
+	tg.F.Comment("Whether every call, storage entry and event of the pallet is compatible")
+	tg.F.Func().Params(jen.Id("p").Id("PalletCompatibility")).Id("Compatible").Params().Bool().Block(
+		jen.If(jen.Op("!").Id("p").Dot("Present")).Block(jen.Return(jen.False())),
+		jen.For(jen.List(jen.Id("_"), jen.Id("items")).Op(":=").Range().Index().Map(jen.String()).Bool().Values(items("p")...)).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Range().Id("items")).Block(
+				jen.If(jen.Op("!").Id("ok")).Block(jen.Return(jen.False())),
+			),
+		),
+		jen.Return(jen.True()),
+	)
+
+	tg.F.Comment("Whether every pallet is compatible")
+	tg.F.Func().Params(jen.Id("r").Id("CompatibilityReport")).Id("Compatible").Params().Bool().Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("p")).Op(":=").Range().Id("r")).Block(
+			jen.If(jen.Op("!").Id("p").Dot("Compatible").Call()).Block(jen.Return(jen.False())),
+		),
+		jen.Return(jen.True()),
+	)
+
+	tg.F.Comment("List the incompatible pallets and items, sorted, like \"Balances\", \"System.call.remark\",")
+	tg.F.Comment("\"System.storage.Account\" or \"System.event.Remarked\"")
+	tg.F.Func().Params(jen.Id("r").Id("CompatibilityReport")).Id("Incompatible").Params().Index().String().Block(
+		jen.Id("res").Op(":=").Index().String().Values(),
+		jen.For(jen.List(jen.Id("name"), jen.Id("p")).Op(":=").Range().Id("r")).Block(
+			jen.If(jen.Op("!").Id("p").Dot("Present")).Block(
+				jen.Id("res").Op("=").Append(jen.Id("res"), jen.Id("name")),
+				jen.Continue(),
+			),
+			jen.For(jen.List(jen.Id("kind"), jen.Id("items")).Op(":=").Range().Map(jen.String()).Map(jen.String()).Bool().Values(jen.Dict{
+				jen.Lit("call"):    jen.Id("p").Dot("Calls"),
+				jen.Lit("storage"): jen.Id("p").Dot("Storage"),
+				jen.Lit("event"):   jen.Id("p").Dot("Events"),
+			})).Block(
+				jen.For(jen.List(jen.Id("item"), jen.Id("ok")).Op(":=").Range().Id("items")).Block(
+					jen.If(jen.Op("!").Id("ok")).Block(
+						jen.Id("res").Op("=").Append(jen.Id("res"), jen.Qual("fmt", "Sprintf").Call(
+							jen.Lit("%v.%v.%v"), jen.Id("name"), jen.Id("kind"), jen.Id("item"),
+						)),
+					),
+				),
+			),
+		),
+		jen.Qual("sort", "Strings").Call(jen.Id("res")),
+		jen.Return(jen.Id("res")),
+	)
+}
+
+// Generate `CheckCompatibility`, which hashes the live metadata and compares it with the hashes
+// of the metadata the code was generated from
+func (tg *TypeGenerator) compatGenCheck() {
+	compare := func(kind string) jen.Code {
+		return jen.Id(kind).Op(":").Id("compare").Call(jen.Id("gen").Dot(kind), jen.Id("live").Dot(kind))
+	}
+
+	tg.F.Comment("Check which of the generated calls, storage entries and events are compatible with the metadata of a")
+	tg.F.Comment("live node, e.g. from state.GetMetadataLatest")
+	tg.F.Func().Id("CheckCompatibility").Params(jen.Id("live").Op("*").Qual(utils.CTYPES, "Metadata")).Params(
+		jen.Id("CompatibilityReport"), jen.Error(),
+	).BlockFunc(func(g *jen.Group) {
+		g.If(jen.Id("live").Dot("Version").Op("!=").Lit(14)).Block(
+			jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(
+				jen.Lit("unsupported metadata version: %v, only v14 is currently supported"), jen.Id("live").Dot("Version"),
+			)),
+		)
+		g.List(jen.Id("liveHashes"), jen.Err()).Op(":=").Id("hashMetadata").Call(jen.Op("&").Id("live").Dot("AsMetadataV14"))
+		utils.ErrorCheckWithNil(g)
+		g.Line()
+		g.Id("compare").Op(":=").Func().Params(jen.List(jen.Id("gen"), jen.Id("live")).Map(jen.String()).String()).Map(jen.String()).Bool().Block(
+			jen.Id("res").Op(":=").Map(jen.String()).Bool().Values(),
+			jen.For(jen.List(jen.Id("name"), jen.Id("h")).Op(":=").Range().Id("gen")).Block(
+				jen.Id("res").Index(jen.Id("name")).Op("=").Id("live").Index(jen.Id("name")).Op("==").Id("h"),
+			),
+			jen.Return(jen.Id("res")),
+		)
+		g.Id("report").Op(":=").Id("CompatibilityReport").Values()
+		g.For(jen.List(jen.Id("name"), jen.Id("gen")).Op(":=").Range().Id("metadataHashes")).Block(
+			jen.List(jen.Id("live"), jen.Id("ok")).Op(":=").Id("liveHashes").Index(jen.Id("name")),
+			jen.Id("report").Index(jen.Id("name")).Op("=").Id("PalletCompatibility").Values(
+				jen.Line().Id("Present").Op(":").Id("ok"),
+				jen.Line().Add(compare("Calls")),
+				jen.Line().Add(compare("Storage")),
+				jen.Line().Add(compare("Events")),
+				jen.Line(),
+			),
+		)
+		g.Return(jen.Id("report"), jen.Nil())
+	})
+}
+
+// Generate `hashMetadata`, a copy of the `metahash` package's hasher, which must hash metadata
+// exactly like it. Types are hashed by their kind, field and variant names, variant indices and the
+// hashes of the types they contain. gen's TestBuildGenerated runs the generated hasher against
+// metahash.Hash, in gen/testdata/usage/hasher_test.go.
+//
+// example (shortened) output:
+//
+//	type metadataHasher struct {
+//		types    map[int64]types.PortableTypeV14
+//		cache    map[int64][]byte
+//		visiting map[int64]bool
+//	}
+//
+//	func hashMetadata(meta *types.MetadataV14) (map[string]palletHashes, error) {...}
+//	func (h *metadataHasher) typeHash(typeId int64) ([]byte, bool, error) {...}
+func (tg *TypeGenerator) compatGenHasher() {
+	ctype := func(name string) *jen.Statement { return jen.Qual(utils.CTYPES, name) }
+	hasher := jen.Id("h").Op("*").Id("metadataHasher")
+	digest := jen.Id("d").Qual("hash", "Hash")
+	write := func(bs ...jen.Code) jen.Code {
+		return jen.Id("d").Dot("Write").Call(jen.Index().Byte().Values(bs...))
+	}
+	writeString := func(s jen.Code) jen.Code {
+		return jen.Id("writeMetadataString").Call(jen.Id("d"), s)
+	}
+	errorf := func(format string, args ...jen.Code) *jen.Statement {
+		return jen.Qual("fmt", "Errorf").Call(append([]jen.Code{jen.Lit(format)}, args...)...)
+	}
+	hexSum := jen.Qual(utils.CCODEC, "HexEncodeToString").Call(jen.Id("d").Dot("Sum").Call(jen.Nil()))
+
+	tg.F.Comment("Hashes types by their structure, like the generator")
+	tg.F.Type().Id("metadataHasher").Struct(
+		jen.Id("types").Map(jen.Int64()).Add(ctype("PortableTypeV14")),
+		jen.Comment("Hashes of types which don't contain a recursive reference, by type id"),
+		jen.Id("cache").Map(jen.Int64()).Index().Byte(),
+		jen.Comment("The types being hashed, to stop recursion"),
+		jen.Id("visiting").Map(jen.Int64()).Bool(),
+	)
+
+	tg.F.Comment("Hash every call, storage entry and event in the metadata, like the generator did")
+	tg.F.Func().Id("hashMetadata").Params(jen.Id("meta").Op("*").Add(ctype("MetadataV14"))).Params(
+		jen.Map(jen.String()).Id("palletHashes"), jen.Error(),
+	).Block(
+		jen.Id("h").Op(":=").Op("&").Id("metadataHasher").Values(jen.Dict{
+			jen.Id("types"):    jen.Map(jen.Int64()).Add(ctype("PortableTypeV14")).Values(),
+			jen.Id("cache"):    jen.Map(jen.Int64()).Index().Byte().Values(),
+			jen.Id("visiting"): jen.Map(jen.Int64()).Bool().Values(),
+		}),
+		jen.For(jen.List(jen.Id("_"), jen.Id("tdef")).Op(":=").Range().Id("meta").Dot("Lookup").Dot("Types")).Block(
+			jen.Id("h").Dot("types").Index(jen.Id("tdef").Dot("ID").Dot("Int64").Call()).Op("=").Id("tdef"),
+		),
+		jen.Id("res").Op(":=").Map(jen.String()).Id("palletHashes").Values(),
+		jen.For(jen.List(jen.Id("_"), jen.Id("pallet")).Op(":=").Range().Id("meta").Dot("Pallets")).BlockFunc(func(g *jen.Group) {
+			g.Id("ph").Op(":=").Id("palletHashes").Values(jen.Dict{
+				jen.Id("Calls"):   jen.Map(jen.String()).String().Values(),
+				jen.Id("Storage"): jen.Map(jen.String()).String().Values(),
+				jen.Id("Events"):  jen.Map(jen.String()).String().Values(),
+			})
+			for _, kind := range []string{"Calls", "Events"} {
+				g.If(jen.Id("pallet").Dot("Has" + kind)).Block(
+					jen.If(
+						jen.Err().Op(":=").Id("h").Dot("variantHashes").Call(
+							jen.Id("ph").Dot(kind), jen.Uint8().Call(jen.Id("pallet").Dot("Index")), jen.Id("pallet").Dot(kind).Dot("Type").Dot("Int64").Call(),
+						),
+						jen.Err().Op("!=").Nil(),
+					).Block(
+						jen.Return(jen.Nil(), errorf(strings.ToLower(kind)+" of pallet %v: %v", jen.Id("pallet").Dot("Name"), jen.Err())),
+					),
+				)
+			}
+			g.If(jen.Id("pallet").Dot("HasStorage")).Block(
+				jen.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("pallet").Dot("Storage").Dot("Items")).Block(
+					jen.List(jen.Id("sh"), jen.Err()).Op(":=").Id("h").Dot("storageHash").Call(
+						jen.String().Call(jen.Id("pallet").Dot("Storage").Dot("Prefix")), jen.Op("&").Id("item"),
+					),
+					jen.If(jen.Err().Op("!=").Nil()).Block(
+						jen.Return(jen.Nil(), errorf("storage %v.%v: %v", jen.Id("pallet").Dot("Name"), jen.Id("item").Dot("Name"), jen.Err())),
+					),
+					jen.Id("ph").Dot("Storage").Index(jen.String().Call(jen.Id("item").Dot("Name"))).Op("=").Id("sh"),
+				),
+			)
+			g.Id("res").Index(jen.String().Call(jen.Id("pallet").Dot("Name"))).Op("=").Id("ph")
+		}),
+		jen.Return(jen.Id("res"), jen.Nil()),
+	)
+
+	tg.F.Comment("Hash each variant of a pallet's call or event type, along with the pallet index, as both are")
+	tg.F.Comment("part of the encoding")
+	tg.F.Func().Params(hasher.Clone()).Id("variantHashes").Params(
+		jen.Id("res").Map(jen.String()).String(), jen.Id("palletIndex").Uint8(), jen.Id("typeId").Int64(),
+	).Error().Block(
+		jen.List(jen.Id("mt"), jen.Id("ok")).Op(":=").Id("h").Dot("types").Index(jen.Id("typeId")),
+		jen.If(jen.Op("!").Id("ok")).Block(jen.Return(errorf("type id=%v not found", jen.Id("typeId")))),
+		jen.If(jen.Op("!").Id("mt").Dot("Type").Dot("Def").Dot("IsVariant")).Block(jen.Return(jen.Nil())),
+		jen.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Id("mt").Dot("Type").Dot("Def").Dot("Variant").Dot("Variants")).Block(
+			jen.Id("d").Op(":=").Id("newMetadataDigest").Call(),
+			write(jen.Id("palletIndex"), jen.Byte().Call(jen.Id("v").Dot("Index"))),
+			writeString(jen.String().Call(jen.Id("v").Dot("Name"))),
+			jen.If(
+				jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("h").Dot("writeFields").Call(jen.Id("d"), jen.Id("v").Dot("Fields")),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return(jen.Err())),
+			jen.Id("res").Index(jen.String().Call(jen.Id("v").Dot("Name"))).Op("=").Add(hexSum.Clone()),
+		),
+		jen.Return(jen.Nil()),
+	)
+
+	modifier := func(name string) jen.Code {
+		return jen.Id("metadataBoolByte").Call(jen.Id("item").Dot("Modifier").Dot(name))
+	}
+	tg.F.Comment("Hash a storage entry: its prefix, name, modifier, hashers and types")
+	tg.F.Func().Params(hasher.Clone()).Id("storageHash").Params(
+		jen.Id("prefix").String(), jen.Id("item").Op("*").Add(ctype("StorageEntryMetadataV14")),
+	).Params(jen.String(), jen.Error()).Block(
+		jen.Id("d").Op(":=").Id("newMetadataDigest").Call(),
+		writeString(jen.Id("prefix")),
+		writeString(jen.String().Call(jen.Id("item").Dot("Name"))),
+		write(modifier("IsOptional"), modifier("IsDefault"), modifier("IsRequired")),
+		jen.Id("ids").Op(":=").Index().Add(ctype("Si1LookupTypeID")).Values(jen.Id("item").Dot("Type").Dot("AsPlainType")),
+		jen.If(jen.Id("item").Dot("Type").Dot("IsPlainType")).Block(
+			write(jen.Lit(0)),
+		).Else().Block(
+			write(jen.Lit(1)),
+			jen.For(jen.List(jen.Id("_"), jen.Id("hs")).Op(":=").Range().Id("item").Dot("Type").Dot("AsMap").Dot("Hashers")).Block(
+				write(jen.Id("metadataHasherByte").Call(jen.Id("hs"))),
+			),
+			jen.Id("ids").Op("=").Index().Add(ctype("Si1LookupTypeID")).Values(
+				jen.Id("item").Dot("Type").Dot("AsMap").Dot("Key"), jen.Id("item").Dot("Type").Dot("AsMap").Dot("Value"),
+			),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("ids")).Block(
+			jen.If(
+				jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("h").Dot("writeType").Call(jen.Id("d"), jen.Id("id")),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return(jen.Lit(""), jen.Err())),
+		),
+		jen.Return(hexSum.Clone(), jen.Nil()),
+	)
+
+	tdef := func() *jen.Statement { return jen.Id("tdef") }
+	tg.F.Comment("Hash a type by its structure. Returns whether the hash includes a recursive reference, in which")
+	tg.F.Comment("case it depends on where the hashing started, so it isn't cached")
+	tg.F.Func().Params(hasher.Clone()).Id("typeHash").Params(jen.Id("typeId").Int64()).Params(
+		jen.Index().Byte(), jen.Bool(), jen.Error(),
+	).Block(
+		jen.If(jen.List(jen.Id("th"), jen.Id("ok")).Op(":=").Id("h").Dot("cache").Index(jen.Id("typeId")), jen.Id("ok")).Block(
+			jen.Return(jen.Id("th"), jen.False(), jen.Nil()),
+		),
+		jen.If(jen.Id("h").Dot("visiting").Index(jen.Id("typeId"))).Block(
+			jen.Id("d").Op(":=").Id("newMetadataDigest").Call(),
+			writeString(jen.Lit("recursive")),
+			jen.Return(jen.Id("d").Dot("Sum").Call(jen.Nil()), jen.True(), jen.Nil()),
+		),
+		jen.List(jen.Id("mt"), jen.Id("ok")).Op(":=").Id("h").Dot("types").Index(jen.Id("typeId")),
+		jen.If(jen.Op("!").Id("ok")).Block(
+			jen.Return(jen.Nil(), jen.False(), errorf("type id=%v not found", jen.Id("typeId"))),
+		),
+		jen.Id("h").Dot("visiting").Index(jen.Id("typeId")).Op("=").True(),
+		jen.Defer().Delete(jen.Id("h").Dot("visiting"), jen.Id("typeId")),
+		jen.Line(),
+		jen.Id("d").Op(":=").Id("newMetadataDigest").Call(),
+		jen.Id("recursive").Op(":=").False(),
+		jen.Var().Err().Error(),
+		jen.Comment("Hash contained types into the digest, until one fails"),
+		jen.Id("inner").Op(":=").Func().Params(jen.Id("ids").Op("...").Add(ctype("Si1LookupTypeID"))).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("ids")).Block(
+				jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return()),
+				jen.Var().Id("rec").Bool(),
+				jen.List(jen.Id("rec"), jen.Err()).Op("=").Id("h").Dot("writeType").Call(jen.Id("d"), jen.Id("id")),
+				jen.Id("recursive").Op("=").Id("recursive").Op("||").Id("rec"),
+			),
+		),
+		jen.Id("fields").Op(":=").Func().Params(jen.Id("fs").Index().Add(ctype("Si1Field"))).Block(
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return()),
+			jen.Var().Id("rec").Bool(),
+			jen.List(jen.Id("rec"), jen.Err()).Op("=").Id("h").Dot("writeFields").Call(jen.Id("d"), jen.Id("fs")),
+			jen.Id("recursive").Op("=").Id("recursive").Op("||").Id("rec"),
+		),
+		jen.Line(),
+		tdef().Op(":=").Id("mt").Dot("Type").Dot("Def"),
+		jen.Switch().Block(
+			jen.Case(tdef().Dot("IsComposite")).Block(
+				write(jen.Lit(0)),
+				jen.Id("fields").Call(tdef().Dot("Composite").Dot("Fields")),
+			),
+			jen.Case(tdef().Dot("IsVariant")).Block(
+				write(jen.Lit(1)),
+				jen.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Add(tdef()).Dot("Variant").Dot("Variants")).Block(
+					write(jen.Byte().Call(jen.Id("v").Dot("Index"))),
+					writeString(jen.String().Call(jen.Id("v").Dot("Name"))),
+					jen.Id("fields").Call(jen.Id("v").Dot("Fields")),
+				),
+			),
+			jen.Case(tdef().Dot("IsSequence")).Block(
+				write(jen.Lit(2)),
+				jen.Id("inner").Call(tdef().Dot("Sequence").Dot("Type")),
+			),
+			jen.Case(tdef().Dot("IsArray")).Block(
+				write(jen.Lit(3)),
+				jen.Id("writeMetadataUint").Call(jen.Id("d"), jen.Uint64().Call(tdef().Dot("Array").Dot("Len"))),
+				jen.Id("inner").Call(tdef().Dot("Array").Dot("Type")),
+			),
+			jen.Case(tdef().Dot("IsTuple")).Block(
+				write(jen.Lit(4)),
+				jen.Id("writeMetadataUint").Call(jen.Id("d"), jen.Uint64().Call(jen.Len(tdef().Dot("Tuple")))),
+				jen.Id("inner").Call(tdef().Dot("Tuple").Op("...")),
+			),
+			jen.Case(tdef().Dot("IsPrimitive")).Block(
+				write(jen.Lit(5), jen.Byte().Call(tdef().Dot("Primitive").Dot("Si0TypeDefPrimitive"))),
+			),
+			jen.Case(tdef().Dot("IsCompact")).Block(
+				write(jen.Lit(6)),
+				jen.Id("inner").Call(tdef().Dot("Compact").Dot("Type")),
+			),
+			jen.Case(tdef().Dot("IsBitSequence")).Block(
+				write(jen.Lit(7)),
+				jen.Id("inner").Call(tdef().Dot("BitSequence").Dot("BitStoreType"), tdef().Dot("BitSequence").Dot("BitOrderType")),
+			),
+			jen.Default().Block(
+				jen.Err().Op("=").Add(errorf("type id=%v has an unknown definition", jen.Id("typeId"))),
+			),
+		),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.False(), jen.Err())),
+		jen.Line(),
+		jen.Id("th").Op(":=").Id("d").Dot("Sum").Call(jen.Nil()),
+		jen.If(jen.Op("!").Id("recursive")).Block(
+			jen.Id("h").Dot("cache").Index(jen.Id("typeId")).Op("=").Id("th"),
+		),
+		jen.Return(jen.Id("th"), jen.Id("recursive"), jen.Nil()),
+	)
+
+	tg.F.Comment("Write the hash of a type. Returns whether it includes a recursive reference")
+	tg.F.Func().Params(hasher.Clone()).Id("writeType").Params(
+		digest.Clone(), jen.Id("id").Add(ctype("Si1LookupTypeID")),
+	).Params(jen.Bool(), jen.Error()).Block(
+		jen.List(jen.Id("th"), jen.Id("rec"), jen.Err()).Op(":=").Id("h").Dot("typeHash").Call(jen.Id("id").Dot("Int64").Call()),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.False(), jen.Err())),
+		jen.Id("d").Dot("Write").Call(jen.Id("th")),
+		jen.Return(jen.Id("rec"), jen.Nil()),
+	)
+
+	tg.F.Comment("Write the names of fields and the hashes of their types. Returns whether any of them includes a")
+	tg.F.Comment("recursive reference")
+	tg.F.Func().Params(hasher.Clone()).Id("writeFields").Params(
+		digest.Clone(), jen.Id("fields").Index().Add(ctype("Si1Field")),
+	).Params(jen.Bool(), jen.Error()).Block(
+		jen.Id("writeMetadataUint").Call(jen.Id("d"), jen.Uint64().Call(jen.Len(jen.Id("fields")))),
+		jen.Id("recursive").Op(":=").False(),
+		jen.For(jen.List(jen.Id("_"), jen.Id("f")).Op(":=").Range().Id("fields")).Block(
+			writeString(jen.String().Call(jen.Id("f").Dot("Name"))),
+			jen.List(jen.Id("rec"), jen.Err()).Op(":=").Id("h").Dot("writeType").Call(jen.Id("d"), jen.Id("f").Dot("Type")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.False(), jen.Err())),
+			jen.Id("recursive").Op("=").Id("recursive").Op("||").Id("rec"),
+		),
+		jen.Return(jen.Id("recursive"), jen.Nil()),
+	)
+
+	tg.F.Func().Id("newMetadataDigest").Params().Qual("hash", "Hash").Block(
+		jen.Comment("Only errors with keys longer than 64 bytes"),
+		jen.List(jen.Id("d"), jen.Id("_")).Op(":=").Qual(utils.GSRPCHash, "NewBlake2b256").Call(jen.Nil()),
+		jen.Return(jen.Id("d")),
+	)
+
+	tg.F.Func().Id("writeMetadataString").Params(digest.Clone(), jen.Id("s").String()).Block(
+		jen.Id("writeMetadataUint").Call(jen.Id("d"), jen.Uint64().Call(jen.Len(jen.Id("s")))),
+		jen.Id("d").Dot("Write").Call(jen.Index().Byte().Call(jen.Id("s"))),
+	)
+
+	tg.F.Func().Id("writeMetadataUint").Params(digest.Clone(), jen.Id("v").Uint64()).Block(
+		jen.Var().Id("b").Index(jen.Lit(8)).Byte(),
+		jen.Qual("encoding/binary", "LittleEndian").Dot("PutUint64").Call(jen.Id("b").Index(jen.Op(":")), jen.Id("v")),
+		jen.Id("d").Dot("Write").Call(jen.Id("b").Index(jen.Op(":"))),
+	)
+
+	tg.F.Func().Id("metadataBoolByte").Params(jen.Id("b").Bool()).Byte().Block(
+		jen.If(jen.Id("b")).Block(jen.Return(jen.Lit(1))),
+		jen.Return(jen.Lit(0)),
+	)
+
+	tg.F.Func().Id("metadataHasherByte").Params(jen.Id("h").Add(ctype("StorageHasherV10"))).Byte().BlockFunc(func(g *jen.Group) {
+		g.Switch().BlockFunc(func(g2 *jen.Group) {
+			for i, name := range []string{"IsBlake2_128", "IsBlake2_256", "IsBlake2_128Concat", "IsTwox128", "IsTwox256", "IsTwox64Concat"} {
+				g2.Case(jen.Id("h").Dot(name)).Block(jen.Return(jen.Lit(i)))
+			}
+		})
+		g.Return(jen.Lit(6))
+	})
+}
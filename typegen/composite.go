@@ -61,6 +61,10 @@ func (tg *TypeGenerator) GenComposite(v *types.Si1TypeDefComposite, mt *types.Po
 		code = append(code, gf.Code...)
 	}
 
+	if tg.aliasShared(mt, sName) {
+		return g, nil
+	}
+
 	// Write new struct with all ids
 	tyPath := utils.PathStrs(mt.Type.Path)
 	tg.F.Comment(fmt.Sprintf("Generated %v with id=%v", strings.Join(tyPath, "_"), mt.ID))
@@ -0,0 +1,80 @@
+package typegen
+
+import (
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/dave/jennifer/jen"
+)
+
+// Variants can contain themselves through pointers, so defaults are only filled this deep
+const maxDefaultDepth = 16
+
+// Generate an expression for a value of the generated type which can be encoded. This is the zero
+// value, except that every variant is set to its first option, as the zero value of a variant
+// can't be encoded. Returns nil if the zero value can be used.
+//
+// example output, for an Era:
+//
+//	Era{IsImmortal: true}
+func (tg *TypeGenerator) DefaultValue(gend GeneratedType) (*jen.Statement, error) {
+	return tg.defaultValue(gend, 0)
+}
+
+func (tg *TypeGenerator) defaultValue(gend GeneratedType, depth int) (*jen.Statement, error) {
+	if depth > maxDefaultDepth {
+		return nil, nil
+	}
+	mt := gend.MType()
+	switch g := gend.(type) {
+	case *VariantGend:
+		variants := mt.Type.Def.Variant.Variants
+		if len(variants) == 0 {
+			return nil, nil
+		}
+		values := jen.Dict{jen.Id(g.IsVarFields[0].Name): jen.True()}
+		for j, f := range variants[0].Fields {
+			field := g.AsVarFields[0][j]
+			value, err := tg.fieldDefault(f.Type.Int64(), field.IsPtr, depth)
+			if err != nil {
+				return nil, err
+			}
+			if value != nil {
+				values[jen.Id(field.Name)] = value
+			}
+		}
+		return jen.Custom(utils.TypeOpts, g.Code()).Values(values), nil
+	case *CompositeGend:
+		values := jen.Dict{}
+		for j, f := range mt.Type.Def.Composite.Fields {
+			field := g.Fields[j]
+			value, err := tg.fieldDefault(f.Type.Int64(), field.IsPtr, depth)
+			if err != nil {
+				return nil, err
+			}
+			if value != nil {
+				values[jen.Id(field.Name)] = value
+			}
+		}
+		if len(values) == 0 {
+			return nil, nil
+		}
+		return jen.Custom(utils.TypeOpts, g.Code()).Values(values), nil
+	}
+	return nil, nil
+}
+
+// Get the default value of a struct field of the given type. Pointer fields are always set, as a
+// nil pointer can't be encoded
+func (tg *TypeGenerator) fieldDefault(typeId int64, isPtr bool, depth int) (*jen.Statement, error) {
+	fGend, err := tg.GetType(typeId)
+	if err != nil {
+		return nil, err
+	}
+	value, err := tg.defaultValue(fGend, depth+1)
+	if err != nil || !isPtr {
+		return value, err
+	}
+	if value == nil {
+		return jen.New(jen.Custom(utils.TypeOpts, fGend.Code())), nil
+	}
+	return jen.Op("&").Add(value), nil
+}
@@ -0,0 +1,333 @@
+package typegen
+
+import (
+	"fmt"
+
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/dave/jennifer/jen"
+)
+
+// The generated types needed to build and read the runtime's extrinsics
+type ExtrinsicGend struct {
+	Gend
+	// The extrinsic format version, without the signed bit
+	Version uint8
+	// The address type of signers, taken from the extrinsic's `Address` param
+	Address GeneratedType
+	// The signature type, taken from the extrinsic's `Signature` param
+	Signature GeneratedType
+	// A struct with the extra data of each signed extension, which is included in signed extrinsics
+	Extra *CompositeGend
+	// A struct with the additional signed data of each signed extension, which is only signed
+	AdditionalSigned *CompositeGend
+}
+
+// Get the extrinsic type of this chain, generating it and the structs for its signed extensions
+// if they were not previously generated. The extrinsic type is a struct holding the signer,
+// signature and signed extension data of a signed extrinsic, and the call, which encodes to the
+// V4 extrinsic format.
+//
+// example (shortened) output:
+//
+//	// The extra data of each of the runtime's signed extensions, included in signed extrinsics
+//	type ExtrinsicExtra struct {
+//		CheckMortality Era
+//		CheckNonce     types.UCompact
+//		...
+//	}
+//
+//	// The additional data of each of the runtime's signed extensions, which is signed but not included in extrinsics
+//	type ExtrinsicAdditionalSigned struct {
+//		CheckSpecVersion uint32
+//		CheckGenesis     [32]byte
+//		...
+//	}
+//
+//	// An extrinsic of the runtime. The signer, signature and extra data are only set if IsSigned
+//	type Extrinsic struct {
+//		IsSigned  bool
+//		Address   MultiAddress
+//		Signature MultiSignature
+//		Extra     ExtrinsicExtra
+//		Call      RuntimeCall
+//	}
+func (tg *TypeGenerator) GetExtrinsicType(ext *types.ExtrinsicV14) (*ExtrinsicGend, error) {
+	if tg.extrinsic != nil {
+		return tg.extrinsic, nil
+	}
+
+	// The address and signature types are the params of sp_runtime's UncheckedExtrinsic
+	mt, ok := tg.mtypes[ext.Type.Int64()]
+	if !ok {
+		return nil, fmt.Errorf("extrinsic type id=%v not found", ext.Type.Int64())
+	}
+	var address, signature GeneratedType
+	for _, p := range mt.Type.Params {
+		if !p.HasType {
+			continue
+		}
+		var err error
+		switch p.Name {
+		case "Address":
+			address, err = tg.GetType(p.Type.Int64())
+		case "Signature":
+			signature, err = tg.GetType(p.Type.Int64())
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if address == nil || signature == nil {
+		return nil, fmt.Errorf("extrinsic type id=%v has no Address and Signature params", ext.Type.Int64())
+	}
+
+	callGend, err := tg.GetCallType()
+	if err != nil {
+		return nil, err
+	}
+
+	extra, err := tg.genSignedExtensionStruct("ExtrinsicExtra", ext, func(se types.SignedExtensionMetadataV14) int64 { return se.Type.Int64() })
+	if err != nil {
+		return nil, err
+	}
+	tg.F.Comment("The extra data of each of the runtime's signed extensions, included in signed extrinsics")
+	tg.F.Type().Id(extra.Name).Struct(genFieldsCode(extra.Fields)...)
+
+	additional, err := tg.genSignedExtensionStruct("ExtrinsicAdditionalSigned", ext, func(se types.SignedExtensionMetadataV14) int64 { return se.AdditionalSigned.Int64() })
+	if err != nil {
+		return nil, err
+	}
+	tg.F.Comment("The additional data of each of the runtime's signed extensions, which is signed but not included in extrinsics")
+	tg.F.Type().Id(additional.Name).Struct(genFieldsCode(additional.Fields)...)
+
+	eg := &ExtrinsicGend{
+		Gend: Gend{
+			Name: tg.uniqueName("Extrinsic"),
+			Pkg:  tg.PkgPath,
+			MTy:  &mt,
+		},
+		Version:          uint8(ext.Version),
+		Address:          address,
+		Signature:        signature,
+		Extra:            extra,
+		AdditionalSigned: additional,
+	}
+	tg.extrinsic = eg
+
+	tg.F.Comment("An extrinsic of the runtime. The signer, signature and extra data are only set if IsSigned")
+	tg.F.Type().Id(eg.Name).Struct(
+		jen.Id("IsSigned").Bool(),
+		jen.Id("Address").Custom(utils.TypeOpts, address.Code()),
+		jen.Id("Signature").Custom(utils.TypeOpts, signature.Code()),
+		jen.Id("Extra").Id(extra.Name),
+		jen.Id("Call").Custom(utils.TypeOpts, callGend.Code()),
+	)
+	tg.extrinsicGenEncode(eg)
+	tg.extrinsicGenDecode(eg)
+
+	return eg, nil
+}
+
+// Build a struct with one field for each signed extension, of the type given by `typeId`
+func (tg *TypeGenerator) genSignedExtensionStruct(name string, ext *types.ExtrinsicV14, typeId func(types.SignedExtensionMetadataV14) int64) (*CompositeGend, error) {
+	g := &CompositeGend{
+		Gend: Gend{
+			Name: tg.uniqueName(name),
+			Pkg:  tg.PkgPath,
+		},
+		Fields: []GenField{},
+	}
+	for _, se := range ext.SignedExtensions {
+		fieldTy, err := tg.GetType(typeId(se))
+		if err != nil {
+			return nil, err
+		}
+		fieldName := utils.AsName(string(se.Identifier))
+		g.Fields = append(g.Fields, GenField{
+			Name: fieldName,
+			Code: []jen.Code{jen.Id(fieldName).Custom(utils.TypeOpts, fieldTy.Code())},
+		})
+	}
+	return g, nil
+}
+
+// Get the code for all of the fields of a struct
+func genFieldsCode(fields []GenField) []jen.Code {
+	code := []jen.Code{}
+	for _, f := range fields {
+		code = append(code, f.Code...)
+	}
+	return code
+}
+
+// Reserve a unique name for a type that isn't in the metadata, appending an integer postfix if a
+// type with the name already exists
+func (tg *TypeGenerator) uniqueName(name string) string {
+	if tg.nameCount[name] == 0 {
+		tg.nameCount[name] = 1
+		return name
+	}
+	tg.nameCount[name] += 1
+	return utils.AsName(name, fmt.Sprint(tg.nameCount[name]-1))
+}
+
+// Generate the encode function for the extrinsic. Extrinsics are encoded as a byte vector holding
+// the version byte, with the top bit set if it's signed, then the signer, signature and extra data
+// if it's signed, and finally the call.
+//
+// example output:
+//
+//	func (ty Extrinsic) Encode(encoder scale.Encoder) (err error) {
+//		var buf bytes.Buffer
+//		inner := scale.NewEncoder(&buf)
+//		if ty.IsSigned {
+//			err = inner.PushByte(132)
+//			if err != nil {
+//				return err
+//			}
+//			err = inner.Encode(ty.Address)
+//			if err != nil {
+//				return err
+//			}
+//			err = inner.Encode(ty.Signature)
+//			if err != nil {
+//				return err
+//			}
+//			err = inner.Encode(ty.Extra)
+//			if err != nil {
+//				return err
+//			}
+//		} else {
+//			err = inner.PushByte(4)
+//			if err != nil {
+//				return err
+//			}
+//		}
+//		err = inner.Encode(ty.Call)
+//		if err != nil {
+//			return err
+//		}
+//		return encoder.Encode(buf.Bytes())
+//	}
+func (tg *TypeGenerator) extrinsicGenEncode(eg *ExtrinsicGend) {
+	tg.F.Func().Params(
+		jen.Id("ty").Id(eg.Name),
+	).Id("Encode").Params(jen.Id("encoder").Qual(SCALE, "Encoder")).Params(
+		jen.Err().Error(),
+	).BlockFunc(func(g1 *jen.Group) {
+		g1.Var().Id("buf").Qual("bytes", "Buffer")
+		g1.Id("inner").Op(":=").Qual(SCALE, "NewEncoder").Call(jen.Op("&").Id("buf"))
+		g1.If(jen.Id("ty").Dot("IsSigned")).BlockFunc(func(g2 *jen.Group) {
+			g2.Err().Op("=").Id("inner").Dot("PushByte").Call(jen.Lit(int(eg.Version | 0x80)))
+			utils.ErrorCheckG(g2)
+			for _, f := range []string{"Address", "Signature", "Extra"} {
+				g2.Err().Op("=").Id("inner").Dot("Encode").Call(jen.Id("ty").Dot(f))
+				utils.ErrorCheckG(g2)
+			}
+		}).Else().BlockFunc(func(g2 *jen.Group) {
+			g2.Err().Op("=").Id("inner").Dot("PushByte").Call(jen.Lit(int(eg.Version)))
+			utils.ErrorCheckG(g2)
+		})
+		g1.Err().Op("=").Id("inner").Dot("Encode").Call(jen.Id("ty").Dot("Call"))
+		utils.ErrorCheckG(g1)
+		g1.Return(jen.Id("encoder").Dot("Encode").Call(jen.Id("buf").Dot("Bytes").Call()))
+	})
+}
+
+// Generate the decode function for the extrinsic, and a `DecodeExtrinsic` function which decodes an
+// extrinsic as found in a block body
+//
+// example output:
+//
+//	func (ty *Extrinsic) Decode(decoder scale.Decoder) (err error) {
+//		var raw []byte
+//		err = decoder.Decode(&raw)
+//		if err != nil {
+//			return err
+//		}
+//		reader := bytes.NewReader(raw)
+//		inner := scale.NewDecoder(reader)
+//		version, err := inner.ReadOneByte()
+//		if err != nil {
+//			return err
+//		}
+//		if version&127 != 4 {
+//			return fmt.Errorf("unsupported extrinsic version %v", version&127)
+//		}
+//		ty.IsSigned = version&128 != 0
+//		if ty.IsSigned {
+//			err = inner.Decode(&ty.Address)
+//			if err != nil {
+//				return err
+//			}
+//			...
+//		}
+//		err = inner.Decode(&ty.Call)
+//		if err != nil {
+//			return err
+//		}
+//		if reader.Len() != 0 {
+//			return fmt.Errorf("%v bytes left after the call of the extrinsic", reader.Len())
+//		}
+//		return nil
+//	}
+//
+//	func DecodeExtrinsic(data []byte) (ret Extrinsic, err error) {
+//		reader := bytes.NewReader(data)
+//		err = scale.NewDecoder(reader).Decode(&ret)
+//		if err != nil {
+//			return
+//		}
+//		if reader.Len() != 0 {
+//			err = fmt.Errorf("%v bytes left after the extrinsic", reader.Len())
+//		}
+//		return
+//	}
+func (tg *TypeGenerator) extrinsicGenDecode(eg *ExtrinsicGend) {
+	tg.F.Func().Params(
+		jen.Id("ty").Op("*").Id(eg.Name),
+	).Id("Decode").Params(jen.Id("decoder").Qual(SCALE, "Decoder")).Params(
+		jen.Err().Error(),
+	).BlockFunc(func(g1 *jen.Group) {
+		// Extrinsics are wrapped in a byte vector
+		g1.Var().Id("raw").Index().Byte()
+		g1.Err().Op("=").Id("decoder").Dot("Decode").Call(jen.Op("&").Id("raw"))
+		utils.ErrorCheckG(g1)
+		g1.Id("reader").Op(":=").Qual("bytes", "NewReader").Call(jen.Id("raw"))
+		g1.Id("inner").Op(":=").Qual(SCALE, "NewDecoder").Call(jen.Id("reader"))
+		g1.List(jen.Id("version"), jen.Err()).Op(":=").Id("inner").Dot("ReadOneByte").Call()
+		utils.ErrorCheckG(g1)
+		g1.If(jen.Id("version").Op("&").Lit(0x7f).Op("!=").Lit(int(eg.Version))).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("unsupported extrinsic version %v"), jen.Id("version").Op("&").Lit(0x7f))),
+		)
+		g1.Id("ty").Dot("IsSigned").Op("=").Id("version").Op("&").Lit(0x80).Op("!=").Lit(0)
+		g1.If(jen.Id("ty").Dot("IsSigned")).BlockFunc(func(g2 *jen.Group) {
+			for _, f := range []string{"Address", "Signature", "Extra"} {
+				g2.Err().Op("=").Id("inner").Dot("Decode").Call(jen.Op("&").Id("ty").Dot(f))
+				utils.ErrorCheckG(g2)
+			}
+		})
+		g1.Err().Op("=").Id("inner").Dot("Decode").Call(jen.Op("&").Id("ty").Dot("Call"))
+		utils.ErrorCheckG(g1)
+		// The length prefix covers exactly the extrinsic
+		g1.If(jen.Id("reader").Dot("Len").Call().Op("!=").Lit(0)).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("%v bytes left after the call of the extrinsic"), jen.Id("reader").Dot("Len").Call())),
+		)
+		g1.Return(jen.Nil())
+	})
+
+	tg.F.Comment(fmt.Sprintf("Decode a SCALE-encoded %v, such as one of the extrinsics in a block body, which fails if", eg.Name))
+	tg.F.Comment("there are bytes left after it")
+	tg.F.Func().Id(utils.AsName("Decode", eg.Name)).Params(jen.Id("data").Index().Byte()).Params(
+		jen.Id("ret").Id(eg.Name), jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		g.Id("reader").Op(":=").Qual("bytes", "NewReader").Call(jen.Id("data"))
+		g.Err().Op("=").Qual(SCALE, "NewDecoder").Call(jen.Id("reader")).Dot("Decode").Call(jen.Op("&").Id("ret"))
+		utils.ErrorCheckWithNamedArgs(g)
+		g.If(jen.Id("reader").Dot("Len").Call().Op("!=").Lit(0)).Block(
+			jen.Err().Op("=").Qual("fmt", "Errorf").Call(jen.Lit("%v bytes left after the extrinsic"), jen.Id("reader").Dot("Len").Call()),
+		)
+		g.Return()
+	})
+}
@@ -2,6 +2,7 @@ package typegen
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/aphoh/go-substrate-gen/utils"
 	types "github.com/centrifuge/go-substrate-rpc-client/v4/types"
@@ -17,10 +18,20 @@ type TypeGenerator struct {
 	F *jen.File
 	// The path to the 'types' path in which to generate all types
 	PkgPath string
+	// Whether the generated functions which talk to a node take a context.Context as their first
+	// argument. This must be set before generating any pallets
+	WithContext bool
+	// Types shared with the packages generated for other runtime versions, or nil. This must be set
+	// before generating any types
+	Shared *SharedTypes
+	// Problems with the metadata which left some code ungenerated, for the caller to report
+	Warnings []string
 
 	// Lazily initialized id for the runtime's call type
 	// This is used to convert extrinsics into actual runnable calls in the client
 	callId *int64
+	// Lazily generated types for the runtime's extrinsics
+	extrinsic *ExtrinsicGend
 
 	// A map from ID -> go-rpc-types
 	mtypes map[int64]types.PortableTypeV14
@@ -32,6 +43,14 @@ type TypeGenerator struct {
 	// A map used to keep track of which rust types should be named based on their full path or full
 	// parameters, instead of stopping at the first unique part
 	namegenOpts map[string]NamegenOpt
+	// A map from ID -> the hash used to share the type between runtime versions, or "" if it isn't
+	// shared
+	sharedHashes map[int64]string
+}
+
+// Record a problem with the metadata which leaves some code ungenerated
+func (tg *TypeGenerator) Warn(format string, args ...interface{}) {
+	tg.Warnings = append(tg.Warnings, fmt.Sprintf(format, args...))
 }
 
 // Options for name generation (ideally for a particular group of rust types)
@@ -70,7 +89,7 @@ func NewTypeGenerator(meta *types.MetadataV14, encodedMetadata string, pkgPath s
 	f.Var().Id("Meta").Qual(utils.CTYPES, "Metadata")
 	f.Var().Id("_").Op("=").Qual(utils.CCODEC, "DecodeFromHex").Call(jen.Id("encMeta"), jen.Op("&").Id("Meta"))
 
-	return TypeGenerator{F: f, PkgPath: pkgPath, mtypes: mtypes, generated: map[int64]GeneratedType{}, nameCount: map[string]uint32{}, namegenOpts: ng}
+	return TypeGenerator{F: f, PkgPath: pkgPath, mtypes: mtypes, generated: map[int64]GeneratedType{}, nameCount: map[string]uint32{}, namegenOpts: ng, sharedHashes: map[int64]string{}}
 }
 
 // Get a jen statement for the metadata of the chain. This is used to create the correct storage key
@@ -115,6 +134,31 @@ func (tg *TypeGenerator) GetType(id int64) (GeneratedType, error) {
 	}
 }
 
+// Get the type generated for the given id, without generating it if it wasn't already
+func (tg *TypeGenerator) Generated(id int64) (GeneratedType, bool) {
+	g, ok := tg.generated[id]
+	return g, ok
+}
+
+// Get the types defined in the types package so far, by name. Types which collapse into other
+// types are only included once, under the name of the type they collapse into.
+func (tg *TypeGenerator) DefinedTypes() map[string]GeneratedType {
+	res := map[string]GeneratedType{}
+	for _, g := range tg.generated {
+		switch gend := g.(type) {
+		case *CompositeGend:
+			res[gend.Name] = gend
+		case *VariantGend:
+			res[gend.Name] = gend
+		case *Gend:
+			if gend.Pkg == tg.PkgPath {
+				res[gend.Name] = gend
+			}
+		}
+	}
+	return res
+}
+
 // Generates args and their string names from a generated type. This recursively pulls away tuples.
 // Index is the starting index for the argument names (e.g. arg1, arg2...)
 // Tuples like (typeA, (typeB, ())) will be flattened into (typeA, typeB)
@@ -149,9 +193,100 @@ func (tg *TypeGenerator) GenerateArgs(gend GeneratedType, index *uint32, namePre
 	return args, names, nil
 }
 
-// Generate all types. This should not be used outside of testing
+// Generates the expressions needed to pass a value of the generated type `gend` as the arguments
+// produced by GenerateArgs. `base` is the name of the variable holding the value.
+// A tuple like (typeA, (typeB, typeC)) held in `k` produces k.Elem0, k.Elem1.Elem0, k.Elem1.Elem1
+func (tg *TypeGenerator) GenerateArgAccessors(gend GeneratedType, base string) ([]jen.Code, error) {
+	return tg.argAccessors(gend, base, []string{})
+}
+
+func (tg *TypeGenerator) argAccessors(gend GeneratedType, base string, fields []string) ([]jen.Code, error) {
+	parsedType := gend.MType().Type
+	if !parsedType.Def.IsTuple {
+		access := jen.Id(base)
+		for _, f := range fields {
+			access = access.Dot(f)
+		}
+		return []jen.Code{access}, nil
+	}
+
+	tdef := parsedType.Def.Tuple
+	codes := []jen.Code{}
+	for i, typeId := range tdef {
+		inner, err := tg.GetType(typeId.Int64())
+		if err != nil {
+			return nil, err
+		}
+		// Singleton tuples collapse to their contained type, so they don't add a field
+		innerFields := fields
+		if len(tdef) > 1 {
+			innerFields = append(append([]string{}, fields...), utils.AsName("Elem", fmt.Sprint(i)))
+		}
+		newCodes, err := tg.argAccessors(inner, base, innerFields)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, newCodes...)
+	}
+	return codes, nil
+}
+
+// Generates the expression building a value of the generated type `gend` from the arguments
+// produced by GenerateArgs, whose names are `names`. This is the inverse of GenerateArgAccessors.
+// Arguments a, b, c of a tuple like (typeA, (typeB, typeC)) produce
+// TupleOf...{Elem0: a, Elem1: TupleOf...{Elem0: b, Elem1: c}}
+func (tg *TypeGenerator) GenerateArgsValue(gend GeneratedType, names []string) (jen.Code, error) {
+	value, rest, err := tg.argsValue(gend, names)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("%v unused arguments building %v", len(rest), gend.DisplayName())
+	}
+	return value, nil
+}
+
+func (tg *TypeGenerator) argsValue(gend GeneratedType, names []string) (*jen.Statement, []string, error) {
+	parsedType := gend.MType().Type
+	if !parsedType.Def.IsTuple {
+		if len(names) == 0 {
+			return nil, nil, fmt.Errorf("missing argument building %v", gend.DisplayName())
+		}
+		return jen.Id(names[0]), names[1:], nil
+	}
+
+	tdef := parsedType.Def.Tuple
+	switch len(tdef) {
+	case 0:
+		// The empty tuple takes no arguments
+		return jen.Struct().Values(), names, nil
+	case 1:
+		// Singleton tuples collapse to their contained type
+		inner, err := tg.GetType(tdef[0].Int64())
+		if err != nil {
+			return nil, nil, err
+		}
+		return tg.argsValue(inner, names)
+	}
+	values := jen.Dict{}
+	for i, typeId := range tdef {
+		inner, err := tg.GetType(typeId.Int64())
+		if err != nil {
+			return nil, nil, err
+		}
+		var value *jen.Statement
+		value, names, err = tg.argsValue(inner, names)
+		if err != nil {
+			return nil, nil, err
+		}
+		values[jen.Id(utils.AsName("Elem", fmt.Sprint(i)))] = value
+	}
+	return jen.Custom(utils.TypeOpts, gend.Code()).Values(values), names, nil
+}
+
+// Generate all types, in order of id. This should not be used outside of testing
 func (tg *TypeGenerator) GenAll() (string, error) {
-	for id := range tg.mtypes {
+	for _, id := range sortedIds(tg.mtypes) {
 		if _, err := tg.GetType(id); err != nil {
 			println("Got error getting type", "type", id, "err", err.Error())
 		}
@@ -159,6 +294,17 @@ func (tg *TypeGenerator) GenAll() (string, error) {
 	return fmt.Sprintf("%#v", tg.F), nil
 }
 
+// Get the ids of the types in ascending order. Generating types in map order would name them
+// differently on every run.
+func sortedIds(mtypes map[int64]types.PortableTypeV14) []int64 {
+	ids := make([]int64, 0, len(mtypes))
+	for id := range mtypes {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
 // Generate a name based on the params (rust generics) of the type. These names may not be unique
 func (tg *TypeGenerator) nameFromParams(base []string, params []types.Si1TypeParameter) (string, error) {
 	sName := utils.AsName(base...)
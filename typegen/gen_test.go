@@ -1,24 +1,79 @@
 package typegen
 
 import (
-	"io/ioutil"
+	"fmt"
+	"os"
 	"strings"
 	"testing"
 
 	"github.com/aphoh/go-substrate-gen/metadata"
+	"github.com/aphoh/go-substrate-gen/metadata/builder"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 	"github.com/stretchr/testify/require"
 )
 
 // TODO: better method for testing
 func TestGenBigMetadata(t *testing.T) {
-	inp, err := ioutil.ReadFile("../polkadot-meta.json")
+	// The example metadata of go-substrate-rpc-client
+	var meta types.Metadata
+	require.NoError(t, codec.DecodeFromHex(types.MetadataV14Data, &meta))
+	tg := NewTypeGenerator(&meta.AsMetadataV14, types.MetadataV14Data, "github.com/aphoh/go-substrate-gen/typegen")
+	res, err := tg.GenAll()
+	require.NoError(t, err)
+	require.False(t, strings.Contains(res, "%!v(PANIC="), "Generated code contains errors")
+
+	// The fixtures, which use every kind of type
+	for _, fixture := range []string{"minimal", "kinds"} {
+		inp, err := os.ReadFile("../testdata/fixtures/" + fixture + ".json")
+		require.NoError(t, err)
+		mr, encMeta, err := metadata.ParseMetadata(inp)
+		require.NoError(t, err)
+
+		tg := NewTypeGenerator(mr, encMeta, "github.com/aphoh/go-substrate-gen/typegen")
+		for _, id := range sortedIds(tg.mtypes) {
+			_, err := tg.GetType(id)
+			require.NoError(t, err, "fixture %v, type id=%v", fixture, id)
+		}
+		require.False(t, strings.Contains(tg.GetGenerated(), "%!v(PANIC="), "Generated code contains errors")
+	}
+}
+
+// Tuple arguments are flattened into one argument per element, and GenerateArgsValue nests them
+// back into the tuple, for Make{Call}Call
+func TestGenerateArgsValue(t *testing.T) {
+	b := builder.New("test_runtime")
+	// (u32, (u64, (bool,)), ())
+	tuple := b.Tuple(
+		b.Primitive(types.IsU32),
+		b.Tuple(b.Primitive(types.IsU64), b.Tuple(b.Primitive(types.IsBool))),
+		b.Tuple(),
+	)
+	meta, err := b.Build()
 	require.NoError(t, err)
-	mr, encMeta, err := metadata.ParseMetadata(inp)
+	tg := NewTypeGenerator(meta, "", "example.com/types")
+	gend, err := tg.GetType(tuple.Int64())
 	require.NoError(t, err)
 
-	tg := NewTypeGenerator(mr, encMeta, "github.com/aphoh/go-substrate-gen/typegen")
-	res, err := tg.GenAll()
+	var index uint32
+	_, names, err := tg.GenerateArgs(gend, &index, "arg")
+	require.NoError(t, err)
+	require.Equal(t, []string{"arg0", "arg1", "arg2"}, names)
 
-	require.False(t, strings.Contains(res, "%!v(PANIC="), "Generated code contains errors")
-	//ioutil.WriteFile("test_out.go", []byte(res), 0644)
+	value, err := tg.GenerateArgsValue(gend, names)
+	require.NoError(t, err)
+	name := gend.DisplayName()
+	require.Equal(t, "types."+name+"{\n"+
+		"\tElem0: arg0,\n"+
+		"\tElem1: types.TupleOfUint64Bool{\n"+
+		"\t\tElem0: arg1,\n"+
+		"\t\tElem1: arg2,\n"+
+		"\t},\n"+
+		"\tElem2: struct{}{},\n"+
+		"}", fmt.Sprintf("%#v", value))
+
+	_, err = tg.GenerateArgsValue(gend, names[1:])
+	require.Error(t, err)
+	_, err = tg.GenerateArgsValue(gend, append(names, "arg3"))
+	require.Error(t, err)
 }
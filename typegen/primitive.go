@@ -31,7 +31,8 @@ func (tg *TypeGenerator) GenPrimitive(primitive *types.Si1TypeDefPrimitive, mt *
 	case types.IsBool:
 		g = &PrimitiveGend{PrimName: "bool", MTy: mt}
 	case types.IsChar:
-		g = &PrimitiveGend{PrimName: "char", MTy: mt}
+		// A char is encoded as the u32 of its unicode scalar value
+		g = &PrimitiveGend{PrimName: "rune", MTy: mt}
 	case types.IsStr:
 		g = &PrimitiveGend{PrimName: "string", MTy: mt}
 	case types.IsU8:
@@ -0,0 +1,51 @@
+package typegen
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/metadata/builder"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenPrimitive(t *testing.T) {
+	want := map[types.Si0TypeDefPrimitive]string{
+		types.IsBool: "bool",
+		types.IsChar: "rune",
+		types.IsStr:  "string",
+		types.IsU8:   "byte",
+		types.IsU16:  "uint16",
+		types.IsU32:  "uint32",
+		types.IsU64:  "uint64",
+		types.IsU128: "types.U128",
+		types.IsU256: "types.U256",
+		types.IsI8:   "int8",
+		types.IsI16:  "int16",
+		types.IsI32:  "int32",
+		types.IsI64:  "int64",
+		types.IsI128: "types.I128",
+		types.IsI256: "types.I256",
+	}
+	b := builder.New("test_runtime")
+	ids := map[types.Si0TypeDefPrimitive]builder.TypeId{}
+	for p := range want {
+		ids[p] = b.Primitive(p)
+	}
+	meta, err := b.Build()
+	require.NoError(t, err)
+
+	tg := NewTypeGenerator(meta, "", "example.com/types")
+	for p, name := range want {
+		id := ids[p]
+		gend, err := tg.GetType(id.Int64())
+		require.NoError(t, err)
+		require.Equal(t, name, fmt.Sprintf("%#v", gend.Code()), "primitive %v", p)
+	}
+
+	// A char is encoded as the u32 of its unicode scalar value, like a rune
+	enc, err := codec.Encode('€')
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xac, 0x20, 0, 0}, enc)
+}
@@ -0,0 +1,590 @@
+package typegen
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/dave/jennifer/jen"
+)
+
+// Generate the round-trip tests and fuzz targets of the types generated so far, as the contents of
+// a types_test.go file in the types package. Each struct, variant and tuple gets a function making
+// random values of it, a test which encodes random values, decodes them and checks they are equal
+// and encode the same again, and a fuzz target decoding arbitrary data, seeded with encoded random
+// values. Values are normalised before comparing them, as big integers and empty slices have
+// several equal representations in go. Fuzz inputs with a length prefix longer than the rest of the
+// input are skipped, as decoding them allocates the whole slice first, which can run out of memory.
+//
+// example (shortened) output:
+//
+//	func randAccountData(r *rand.Rand, depth int) (v AccountData) {
+//		v.Free = types.NewU128(*randBig(r, 128, false))
+//		v.Flags = r.Uint32()
+//		return
+//	}
+//
+//	func TestRoundTripAccountData(t *testing.T) {
+//		r := rand.New(rand.NewSource(1))
+//		for i := 0; i < roundTrips; i++ {
+//			v := randAccountData(r, randDepth)
+//			checkRoundTrip(t, &v, new(AccountData))
+//		}
+//	}
+//
+//	func FuzzDecodeAccountData(f *testing.F) {
+//		r := rand.New(rand.NewSource(1))
+//		for i := 0; i < fuzzSeeds; i++ {
+//			v := randAccountData(r, randDepth)
+//			f.Add(encodeSeed(f, &v))
+//		}
+//		f.Fuzz(func(t *testing.T, data []byte) {
+//			if !fitsData(12, data) {
+//				return
+//			}
+//			checkDecode(t, data, new(AccountData), new(AccountData))
+//		})
+//	}
+func (tg *TypeGenerator) GenerateRoundTripTests() (string, error) {
+	f := jen.NewFilePath(tg.PkgPath)
+	f.HeaderComment("Round-trip tests and fuzz targets of the generated types")
+	genRoundTripHelpers(f, tg.PkgPath)
+
+	defined := tg.DefinedTypes()
+	names := make([]string, 0, len(defined))
+	for name := range defined {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := tg.genRandFunc(f, defined[name]); err != nil {
+			return "", fmt.Errorf("error generating random values of %v: %v", name, err)
+		}
+	}
+	for _, name := range names {
+		randCall := jen.Id("v").Op(":=").Id("rand"+name).Call(jen.Id("r"), jen.Id("randDepth"))
+		f.Func().Id("TestRoundTrip"+name).Params(jen.Id("t").Op("*").Qual("testing", "T")).Block(
+			jen.Id("r").Op(":=").Qual("math/rand", "New").Call(jen.Qual("math/rand", "NewSource").Call(jen.Lit(1))),
+			jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("roundTrips"), jen.Id("i").Op("++")).Block(
+				randCall,
+				jen.Id("checkRoundTrip").Call(jen.Id("t"), jen.Op("&").Id("v"), jen.New(jen.Id(name))),
+			),
+		)
+		f.Line()
+		f.Func().Id("FuzzDecode"+name).Params(jen.Id("f").Op("*").Qual("testing", "F")).Block(
+			jen.Id("r").Op(":=").Qual("math/rand", "New").Call(jen.Qual("math/rand", "NewSource").Call(jen.Lit(1))),
+			jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("fuzzSeeds"), jen.Id("i").Op("++")).Block(
+				randCall,
+				jen.Id("f").Dot("Add").Call(jen.Id("encodeSeed").Call(jen.Id("f"), jen.Op("&").Id("v"))),
+			),
+			jen.Id("f").Dot("Fuzz").Call(jen.Func().Params(jen.Id("t").Op("*").Qual("testing", "T"), jen.Id("data").Index().Byte()).Block(
+				jen.If(jen.Op("!").Id("fitsData").Call(jen.Lit(defined[name].MType().ID.Int64()), jen.Id("data"))).Block(jen.Return()),
+				jen.Id("checkDecode").Call(jen.Id("t"), jen.Id("data"), jen.New(jen.Id(name)), jen.New(jen.Id(name))),
+			)),
+		)
+		f.Line()
+	}
+	return fmt.Sprintf("%#v", f), nil
+}
+
+// Generate a function making a random value of a generated struct, variant or tuple. The depth
+// limits recursion: below it, slices are empty and variants are their first variant.
+func (tg *TypeGenerator) genRandFunc(f *jen.File, gend GeneratedType) error {
+	name := gend.DisplayName()
+	def := gend.MType().Type.Def
+	body := []jen.Code{}
+	switch g := gend.(type) {
+	case *CompositeGend:
+		for i, field := range def.Composite.Fields {
+			assign, err := tg.randAssign(g.Fields[i], field.Type.Int64())
+			if err != nil {
+				return err
+			}
+			body = append(body, assign)
+		}
+	case *VariantGend:
+		// Pick a variant by its position
+		body = append(body,
+			jen.Id("n").Op(":=").Lit(0),
+			jen.If(jen.Id("depth").Op(">").Lit(0)).Block(
+				jen.Id("n").Op("=").Id("r").Dot("Intn").Call(jen.Lit(len(def.Variant.Variants))),
+			),
+		)
+		cases := []jen.Code{}
+		for i, variant := range def.Variant.Variants {
+			caseBody := []jen.Code{jen.Id("v").Dot(g.IsVarFields[i].Name).Op("=").True()}
+			for j, field := range variant.Fields {
+				assign, err := tg.randAssign(g.AsVarFields[i][j], field.Type.Int64())
+				if err != nil {
+					return err
+				}
+				caseBody = append(caseBody, assign)
+			}
+			cases = append(cases, jen.Case(jen.Lit(i)).Block(caseBody...))
+		}
+		body = append(body, jen.Switch(jen.Id("n")).Block(cases...))
+	case *Gend:
+		if !def.IsTuple {
+			return fmt.Errorf("unsupported defined type")
+		}
+		for i, te := range def.Tuple {
+			assign, err := tg.randAssign(GenField{Name: utils.AsName("Elem", fmt.Sprint(i))}, te.Int64())
+			if err != nil {
+				return err
+			}
+			body = append(body, assign)
+		}
+	default:
+		return fmt.Errorf("unsupported defined type")
+	}
+	body = append(body, jen.Return())
+
+	f.Func().Id("rand"+name).Params(
+		jen.Id("r").Op("*").Qual("math/rand", "Rand"), jen.Id("depth").Int(),
+	).Params(jen.Id("v").Id(name)).Block(body...)
+	f.Line()
+	return nil
+}
+
+// Assign a random value of the type with the given id to a field of v
+func (tg *TypeGenerator) randAssign(field GenField, typeId int64) (jen.Code, error) {
+	gend, err := tg.GetType(typeId)
+	if err != nil {
+		return nil, err
+	}
+	value, err := tg.randValue(gend)
+	if err != nil {
+		return nil, err
+	}
+	if field.IsPtr {
+		value = jen.Id("ptr").Call(value)
+	}
+	return jen.Id("v").Dot(field.Name).Op("=").Add(value), nil
+}
+
+// Get an expression making a random value of a generated type, using `r` and `depth`
+func (tg *TypeGenerator) randValue(gend GeneratedType) (*jen.Statement, error) {
+	r := jen.Id("r")
+	switch g := gend.(type) {
+	case *PrimitiveGend:
+		switch g.PrimName {
+		case "bool":
+			return r.Dot("Intn").Call(jen.Lit(2)).Op("==").Lit(1), nil
+		case "string":
+			return jen.Id("randString").Call(r, jen.Id("depth")), nil
+		case "rune", "int32":
+			return jen.Id(g.PrimName).Call(r.Dot("Int31").Call()), nil
+		case "byte", "uint16", "int8", "int16":
+			return jen.Id(g.PrimName).Call(r.Dot("Uint32").Call()), nil
+		case "uint32":
+			return r.Dot("Uint32").Call(), nil
+		case "uint64":
+			return r.Dot("Uint64").Call(), nil
+		case "int64":
+			return jen.Int64().Call(r.Dot("Uint64").Call()), nil
+		case "struct{}":
+			return jen.Struct().Values(), nil
+		}
+	case *VariantGend:
+		return jen.Id("rand"+g.Name).Call(r, jen.Id("depth").Op("-").Lit(1)), nil
+	case *CompositeGend:
+		return jen.Id("rand"+g.Name).Call(r, jen.Id("depth").Op("-").Lit(1)), nil
+	case *Gend:
+		if g.Pkg == tg.PkgPath {
+			return jen.Id("rand"+g.Name).Call(r, jen.Id("depth").Op("-").Lit(1)), nil
+		}
+		if g.Pkg == utils.CTYPES {
+			switch g.Name {
+			case "U128", "U256", "I128", "I256":
+				bits := 128
+				if g.Name[1:] == "256" {
+					bits = 256
+				}
+				return jen.Qual(utils.CTYPES, "New"+g.Name).Call(
+					jen.Op("*").Id("randBig").Call(r, jen.Lit(bits), jen.Lit(g.Name[0] == 'I')),
+				), nil
+			case "UCompact":
+				return jen.Qual(utils.CTYPES, "NewUCompact").Call(jen.Id("randBig").Call(r, jen.Lit(64), jen.False())), nil
+			}
+		}
+	case *SliceGend:
+		if pg, ok := g.Inner.(*PrimitiveGend); ok && pg.PrimName == "byte" {
+			return jen.Id("randBytes").Call(r, jen.Id("depth")), nil
+		}
+		inner, err := tg.randValue(g.Inner)
+		if err != nil {
+			return nil, err
+		}
+		// func() []T { s := make([]T, randLen(r, depth)); for i := range s { s[i] = ... }; return s }()
+		return jen.Func().Params().Custom(utils.TypeOpts, g.Code()).Block(
+			jen.Id("s").Op(":=").Make(jen.Custom(utils.TypeOpts, g.Code()), jen.Id("randLen").Call(r, jen.Id("depth"))),
+			jen.For(jen.Id("i").Op(":=").Range().Id("s")).Block(jen.Id("s").Index(jen.Id("i")).Op("=").Add(inner)),
+			jen.Return(jen.Id("s")),
+		).Call(), nil
+	case *ArrayGend:
+		inner, err := tg.randValue(g.Inner)
+		if err != nil {
+			return nil, err
+		}
+		// func() (a [N]T) { for i := range a { a[i] = ... }; return }()
+		return jen.Func().Params().Params(jen.Id("a").Custom(utils.TypeOpts, g.Code())).Block(
+			jen.For(jen.Id("i").Op(":=").Range().Id("a")).Block(jen.Id("a").Index(jen.Id("i")).Op("=").Add(inner)),
+			jen.Return(),
+		).Call(), nil
+	}
+	return nil, fmt.Errorf("unsupported type %v", gend.DisplayName())
+}
+
+// Generate the helpers shared by the round-trip tests and fuzz targets of the types in pkgPath
+func genRoundTripHelpers(f *jen.File, pkgPath string) {
+	f.Comment("The number of random values round-tripped by each test, and added to the seed corpus of each fuzz target")
+	f.Const().Defs(
+		jen.Id("roundTrips").Op("=").Lit(100),
+		jen.Id("fuzzSeeds").Op("=").Lit(8),
+	)
+	f.Comment("How deeply random values nest")
+	f.Const().Id("randDepth").Op("=").Lit(3)
+	f.Line()
+
+	// func checkRoundTrip(t *testing.T, v interface{}, into interface{})
+	f.Comment("Check that a value decodes from its encoding, consuming all of it, into an equal value with the same encoding")
+	f.Func().Id("checkRoundTrip").Params(
+		jen.Id("t").Op("*").Qual("testing", "T"), jen.Id("v").Interface(), jen.Id("into").Interface(),
+	).Block(
+		jen.Id("t").Dot("Helper").Call(),
+		jen.List(jen.Id("enc"), jen.Err()).Op(":=").Qual(utils.CCODEC, "Encode").Call(jen.Id("v")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Id("t").Dot("Fatalf").Call(jen.Lit("error encoding %+v: %v"), jen.Id("v"), jen.Err()),
+		),
+		jen.Id("checkDecodeAll").Call(jen.Id("t"), jen.Id("enc"), jen.Id("into")),
+		jen.List(jen.Id("again"), jen.Err()).Op(":=").Qual(utils.CCODEC, "Encode").Call(jen.Id("into")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Id("t").Dot("Fatalf").Call(jen.Lit("error encoding decoded %+v: %v"), jen.Id("into"), jen.Err()),
+		),
+		jen.If(jen.Op("!").Qual("bytes", "Equal").Call(jen.Id("enc"), jen.Id("again"))).Block(
+			jen.Id("t").Dot("Fatalf").Call(jen.Lit("%+v encodes to %x, but decodes to %+v which encodes to %x"), jen.Id("v"), jen.Id("enc"), jen.Id("into"), jen.Id("again")),
+		),
+		jen.Id("normalize").Call(jen.Qual("reflect", "ValueOf").Call(jen.Id("v"))),
+		jen.Id("normalize").Call(jen.Qual("reflect", "ValueOf").Call(jen.Id("into"))),
+		jen.If(jen.Op("!").Qual("reflect", "DeepEqual").Call(jen.Id("v"), jen.Id("into"))).Block(
+			jen.Id("t").Dot("Fatalf").Call(jen.Lit("%+v encodes to %x, but decodes to %+v"), jen.Id("v"), jen.Id("enc"), jen.Id("into")),
+		),
+	)
+	f.Line()
+
+	// func normalize(v reflect.Value)
+	f.Comment("Normalise the go representations of equal values in place: empty slices become nil, and big")
+	f.Comment("integers take their canonical form")
+	f.Func().Id("normalize").Params(jen.Id("v").Qual("reflect", "Value")).Block(
+		jen.Switch(jen.Id("v").Dot("Kind").Call()).Block(
+			jen.Case(jen.Qual("reflect", "Pointer")).Block(
+				jen.If(jen.Op("!").Id("v").Dot("IsNil").Call()).Block(
+					jen.Id("normalize").Call(jen.Id("v").Dot("Elem").Call()),
+				),
+			),
+			jen.Case(jen.Qual("reflect", "Slice")).Block(
+				jen.If(jen.Id("v").Dot("Len").Call().Op("==").Lit(0)).Block(
+					jen.Id("v").Dot("Set").Call(jen.Qual("reflect", "Zero").Call(jen.Id("v").Dot("Type").Call())),
+				),
+				jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("v").Dot("Len").Call(), jen.Id("i").Op("++")).Block(
+					jen.Id("normalize").Call(jen.Id("v").Dot("Index").Call(jen.Id("i"))),
+				),
+			),
+			jen.Case(jen.Qual("reflect", "Array")).Block(
+				jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("v").Dot("Len").Call(), jen.Id("i").Op("++")).Block(
+					jen.Id("normalize").Call(jen.Id("v").Dot("Index").Call(jen.Id("i"))),
+				),
+			),
+			jen.Case(jen.Qual("reflect", "Struct")).Block(
+				jen.Comment("Big integers, and types like UCompact defined as them"),
+				jen.Id("bigInt").Op(":=").Qual("reflect", "TypeOf").Call(jen.Qual("math/big", "Int").Values()),
+				jen.If(jen.Id("v").Dot("Type").Call().Dot("ConvertibleTo").Call(jen.Id("bigInt"))).Block(
+					jen.Id("n").Op(":=").Id("v").Dot("Addr").Call().Dot("Convert").Call(
+						jen.Qual("reflect", "PointerTo").Call(jen.Id("bigInt")),
+					).Dot("Interface").Call().Assert(jen.Op("*").Qual("math/big", "Int")),
+					jen.Id("v").Dot("Set").Call(
+						jen.Qual("reflect", "ValueOf").Call(jen.New(jen.Qual("math/big", "Int")).Dot("Set").Call(jen.Id("n"))).Dot("Elem").Call().Dot("Convert").Call(jen.Id("v").Dot("Type").Call()),
+					),
+					jen.Return(),
+				),
+				jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("v").Dot("NumField").Call(), jen.Id("i").Op("++")).Block(
+					jen.If(jen.Id("v").Dot("Type").Call().Dot("Field").Call(jen.Id("i")).Dot("IsExported").Call()).Block(
+						jen.Id("normalize").Call(jen.Id("v").Dot("Field").Call(jen.Id("i"))),
+					),
+				),
+			),
+		),
+	)
+	f.Line()
+
+	// func checkDecode(t *testing.T, data []byte, into interface{}, again interface{})
+	f.Comment("Check that if data decodes, the decoded value round-trips. The scale decoder ignores the")
+	f.Comment("error reading a slice length, and panics on some truncated or garbage input: that is a failed")
+	f.Comment("decode too. A panic in a generated Decode method fails the test.")
+	f.Func().Id("checkDecode").Params(
+		jen.Id("t").Op("*").Qual("testing", "T"), jen.Id("data").Index().Byte(), jen.Id("into").Interface(), jen.Id("again").Interface(),
+	).Block(
+		jen.Id("decoded").Op(":=").Func().Params().Params(jen.Id("ok").Bool()).Block(
+			jen.Defer().Func().Params().Block(
+				jen.If(jen.Id("r").Op(":=").Recover(), jen.Id("r").Op("!=").Nil()).Block(
+					jen.If(jen.Op("!").Id("scalePanic").Call()).Block(jen.Panic(jen.Id("r"))),
+					jen.Id("ok").Op("=").False(),
+				),
+			).Call(),
+			jen.Return(jen.Qual(utils.CCODEC, "Decode").Call(jen.Id("data"), jen.Id("into")).Op("==").Nil()),
+		),
+		jen.If(jen.Op("!").Id("decoded").Call()).Block(
+			jen.Return(),
+		),
+		jen.Id("checkRoundTrip").Call(jen.Id("t"), jen.Id("into"), jen.Id("again")),
+	)
+	f.Line()
+
+	// func scalePanic() bool
+	f.Comment("Whether the panic being recovered was raised by the scale decoder rather than the generated code,")
+	f.Comment("by which of them is innermost in the stack of the deferred function recovering it")
+	f.Func().Id("scalePanic").Params().Bool().Block(
+		jen.Id("pcs").Op(":=").Make(jen.Index().Uintptr(), jen.Lit(64)),
+		jen.Comment("Skip runtime.Callers, scalePanic and the deferred function"),
+		jen.Id("frames").Op(":=").Qual("runtime", "CallersFrames").Call(
+			jen.Id("pcs").Index(jen.Empty(), jen.Qual("runtime", "Callers").Call(jen.Lit(3), jen.Id("pcs"))),
+		),
+		jen.For().Block(
+			jen.List(jen.Id("frame"), jen.Id("more")).Op(":=").Id("frames").Dot("Next").Call(),
+			jen.Switch().Block(
+				jen.Case(jen.Qual("strings", "HasPrefix").Call(jen.Id("frame").Dot("Function"), jen.Lit(SCALE+"."))).Block(
+					jen.Return(jen.True()),
+				),
+				jen.Case(jen.Qual("strings", "HasPrefix").Call(jen.Id("frame").Dot("Function"), jen.Lit(pkgPath+"."))).Block(
+					jen.Return(jen.False()),
+				),
+			),
+			jen.If(jen.Op("!").Id("more")).Block(jen.Return(jen.False())),
+		),
+	)
+	f.Line()
+
+	// func checkDecodeAll(t *testing.T, enc []byte, into interface{})
+	f.Func().Id("checkDecodeAll").Params(
+		jen.Id("t").Op("*").Qual("testing", "T"), jen.Id("enc").Index().Byte(), jen.Id("into").Interface(),
+	).Block(
+		jen.Id("t").Dot("Helper").Call(),
+		jen.Id("reader").Op(":=").Qual("bytes", "NewReader").Call(jen.Id("enc")),
+		jen.If(jen.Err().Op(":=").Qual(SCALE, "NewDecoder").Call(jen.Id("reader")).Dot("Decode").Call(jen.Id("into")), jen.Err().Op("!=").Nil()).Block(
+			jen.Id("t").Dot("Fatalf").Call(jen.Lit("error decoding %x: %v"), jen.Id("enc"), jen.Err()),
+		),
+		jen.If(jen.Id("reader").Dot("Len").Call().Op("!=").Lit(0)).Block(
+			jen.Id("t").Dot("Fatalf").Call(jen.Lit("%v bytes of %x left after decoding"), jen.Id("reader").Dot("Len").Call(), jen.Id("enc")),
+		),
+	)
+	f.Line()
+
+	genFitsData(f)
+
+	f.Func().Id("encodeSeed").Params(jen.Id("f").Op("*").Qual("testing", "F"), jen.Id("v").Interface()).Index().Byte().Block(
+		jen.List(jen.Id("enc"), jen.Err()).Op(":=").Qual(utils.CCODEC, "Encode").Call(jen.Id("v")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Id("f").Dot("Fatalf").Call(jen.Lit("error encoding %+v: %v"), jen.Id("v"), jen.Err()),
+		),
+		jen.Return(jen.Id("enc")),
+	)
+	f.Line()
+
+	f.Func().Id("ptr").Types(jen.Id("T").Any()).Params(jen.Id("v").Id("T")).Op("*").Id("T").Block(jen.Return(jen.Op("&").Id("v")))
+	f.Line()
+
+	// The length of random slices and strings, which are empty below the depth limit
+	f.Func().Id("randLen").Params(jen.Id("r").Op("*").Qual("math/rand", "Rand"), jen.Id("depth").Int()).Int().Block(
+		jen.If(jen.Id("depth").Op("<=").Lit(0)).Block(jen.Return(jen.Lit(0))),
+		jen.Return(jen.Id("r").Dot("Intn").Call(jen.Lit(4))),
+	)
+	f.Line()
+
+	f.Func().Id("randBytes").Params(jen.Id("r").Op("*").Qual("math/rand", "Rand"), jen.Id("depth").Int()).Index().Byte().Block(
+		jen.Id("b").Op(":=").Make(jen.Index().Byte(), jen.Id("randLen").Call(jen.Id("r"), jen.Id("depth"))),
+		jen.Id("r").Dot("Read").Call(jen.Id("b")),
+		jen.Return(jen.Id("b")),
+	)
+	f.Line()
+
+	f.Func().Id("randString").Params(jen.Id("r").Op("*").Qual("math/rand", "Rand"), jen.Id("depth").Int()).String().Block(
+		jen.Id("b").Op(":=").Make(jen.Index().Byte(), jen.Id("randLen").Call(jen.Id("r"), jen.Id("depth"))),
+		jen.For(jen.Id("i").Op(":=").Range().Id("b")).Block(
+			jen.Id("b").Index(jen.Id("i")).Op("=").Lit(byte('a')).Op("+").Byte().Call(jen.Id("r").Dot("Intn").Call(jen.Lit(26))),
+		),
+		jen.Return(jen.String().Call(jen.Id("b"))),
+	)
+	f.Line()
+
+	// A random integer of the given number of bits, which is signed in two's complement if `signed`
+	f.Func().Id("randBig").Params(jen.Id("r").Op("*").Qual("math/rand", "Rand"), jen.Id("bits").Int(), jen.Id("signed").Bool()).Op("*").Qual("math/big", "Int").Block(
+		jen.Id("limit").Op(":=").Qual("math/big", "NewInt").Call(jen.Lit(1)).Dot("Lsh").Call(jen.Qual("math/big", "NewInt").Call(jen.Lit(1)), jen.Uint().Call(jen.Id("bits"))),
+		jen.Id("v").Op(":=").New(jen.Qual("math/big", "Int")).Dot("Rand").Call(jen.Id("r"), jen.Id("limit")),
+		jen.If(jen.Id("signed")).Block(
+			jen.Id("v").Dot("Sub").Call(jen.Id("v"), jen.Id("limit").Dot("Rsh").Call(jen.Id("limit"), jen.Lit(1))),
+		),
+		jen.Return(jen.Id("v")),
+	)
+}
+
+// Generate `fitsData`, which walks the encoding of a value at the start of fuzz input by the type
+// definitions in the metadata, and checks every length prefix is at most the number of bytes left.
+// The scale decoder makes a slice of the encoded length before decoding any items, so a garbage
+// length prefix can allocate more memory than there is, which is a fatal error rather than a panic.
+//
+// example (shortened) output:
+//
+//	func fitsData(id int64, data []byte) bool {
+//		_, ok := skipValue(id, data, 0)
+//		return ok
+//	}
+//
+//	func skipValue(id int64, data []byte, pos int) (int, bool) {...}
+//	func skipCompact(data []byte, pos int) (uint64, int, bool) {...}
+func genFitsData(f *jen.File) {
+	ok := jen.Id("ok")
+	fail := jen.Return(jen.Id("pos"), jen.False())
+	// Skip a value of the type `id` at pos, failing if it doesn't fit
+	skip := func(id jen.Code) jen.Code {
+		return jen.If(
+			jen.List(jen.Id("pos"), ok).Op("=").Id("skipValue").Call(id, jen.Id("data"), jen.Id("pos")),
+			jen.Op("!").Add(ok),
+		).Block(fail)
+	}
+	// Skip a compact length prefix, and the `n` items after it
+	skipItems := func(item jen.Code) []jen.Code {
+		return []jen.Code{
+			jen.List(jen.Id("n"), jen.Id("next"), ok).Op(":=").Id("skipCompact").Call(jen.Id("data"), jen.Id("pos")),
+			jen.If(jen.Op("!").Add(ok).Op("||").Id("n").Op(">").Uint64().Call(jen.Len(jen.Id("data")).Op("-").Id("next"))).Block(fail),
+			jen.Id("pos").Op("=").Id("next"),
+			item,
+		}
+	}
+
+	f.Comment("The type definitions of the metadata, by id")
+	f.Var().Id("metaTypes").Op("=").Func().Params().Map(jen.Int64()).Qual(utils.CTYPES, "Si1TypeDef").Block(
+		jen.Id("res").Op(":=").Map(jen.Int64()).Qual(utils.CTYPES, "Si1TypeDef").Values(),
+		jen.For(jen.List(jen.Id("_"), jen.Id("mt")).Op(":=").Range().Id("Meta").Dot("AsMetadataV14").Dot("Lookup").Dot("Types")).Block(
+			jen.Id("res").Index(jen.Id("mt").Dot("ID").Dot("Int64").Call()).Op("=").Id("mt").Dot("Type").Dot("Def"),
+		),
+		jen.Return(jen.Id("res")),
+	).Call()
+	f.Line()
+
+	f.Comment("The encoded sizes of the fixed size primitives, by Si0TypeDefPrimitive")
+	f.Var().Id("primitiveSizes").Op("=").Map(jen.Qual(utils.CTYPES, "Si0TypeDefPrimitive")).Int().Values(jen.DictFunc(func(d jen.Dict) {
+		sizes := map[string]int{
+			"IsBool": 1, "IsChar": 4, "IsU8": 1, "IsU16": 2, "IsU32": 4, "IsU64": 8, "IsU128": 16, "IsU256": 32,
+			"IsI8": 1, "IsI16": 2, "IsI32": 4, "IsI64": 8, "IsI128": 16, "IsI256": 32,
+		}
+		for name, size := range sizes {
+			d[jen.Qual(utils.CTYPES, name)] = jen.Lit(size)
+		}
+	}))
+	f.Line()
+
+	f.Comment("Whether every length prefix in the encoding of a value of the type `id` at the start of data is")
+	f.Comment("at most the number of bytes left after it. The scale decoder makes a slice of the encoded length")
+	f.Comment("before decoding its items, so decoding a garbage length can run out of memory, which can't be")
+	f.Comment("recovered from.")
+	f.Func().Id("fitsData").Params(jen.Id("id").Int64(), jen.Id("data").Index().Byte()).Bool().Block(
+		jen.List(jen.Id("_"), ok).Op(":=").Id("skipValue").Call(jen.Id("id"), jen.Id("data"), jen.Lit(0)),
+		jen.Return(ok),
+	)
+	f.Line()
+
+	f.Comment("Skip the encoding of a value of the type `id` at pos, returning the position after it, or false if")
+	f.Comment("a length prefix is too long, the data ends, or the encoding isn't of the type")
+	f.Func().Id("skipValue").Params(jen.Id("id").Int64(), jen.Id("data").Index().Byte(), jen.Id("pos").Int()).Params(jen.Int(), jen.Bool()).BlockFunc(func(g *jen.Group) {
+		g.List(jen.Id("def"), ok).Op(":=").Id("metaTypes").Index(jen.Id("id"))
+		g.If(jen.Op("!").Add(ok)).Block(fail)
+		g.Switch().Block(
+			jen.Case(jen.Id("def").Dot("IsComposite")).Block(
+				jen.For(jen.List(jen.Id("_"), jen.Id("field")).Op(":=").Range().Id("def").Dot("Composite").Dot("Fields")).Block(
+					skip(jen.Id("field").Dot("Type").Dot("Int64").Call()),
+				),
+			),
+			jen.Case(jen.Id("def").Dot("IsVariant")).Block(
+				jen.If(jen.Id("pos").Op(">=").Len(jen.Id("data"))).Block(fail),
+				jen.Id("found").Op(":=").False(),
+				jen.For(jen.List(jen.Id("_"), jen.Id("v")).Op(":=").Range().Id("def").Dot("Variant").Dot("Variants")).Block(
+					jen.If(jen.Byte().Call(jen.Id("v").Dot("Index")).Op("!=").Id("data").Index(jen.Id("pos"))).Block(jen.Continue()),
+					jen.Id("found").Op("=").True(),
+					jen.Id("pos").Op("++"),
+					jen.For(jen.List(jen.Id("_"), jen.Id("field")).Op(":=").Range().Id("v").Dot("Fields")).Block(
+						skip(jen.Id("field").Dot("Type").Dot("Int64").Call()),
+					),
+					jen.Break(),
+				),
+				jen.If(jen.Op("!").Id("found")).Block(fail),
+			),
+			jen.Case(jen.Id("def").Dot("IsSequence")).Block(skipItems(
+				jen.For(jen.Id("i").Op(":=").Uint64().Call(jen.Lit(0)), jen.Id("i").Op("<").Id("n"), jen.Id("i").Op("++")).Block(
+					skip(jen.Id("def").Dot("Sequence").Dot("Type").Dot("Int64").Call()),
+				),
+			)...),
+			jen.Case(jen.Id("def").Dot("IsArray")).Block(
+				jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Int().Call(jen.Id("def").Dot("Array").Dot("Len")), jen.Id("i").Op("++")).Block(
+					skip(jen.Id("def").Dot("Array").Dot("Type").Dot("Int64").Call()),
+				),
+			),
+			jen.Case(jen.Id("def").Dot("IsTuple")).Block(
+				jen.For(jen.List(jen.Id("_"), jen.Id("elem")).Op(":=").Range().Id("def").Dot("Tuple")).Block(
+					skip(jen.Id("elem").Dot("Int64").Call()),
+				),
+			),
+			jen.Case(jen.Id("def").Dot("IsPrimitive").Op("&&").Id("def").Dot("Primitive").Dot("Si0TypeDefPrimitive").Op("==").Qual(utils.CTYPES, "IsStr")).Block(skipItems(
+				jen.Id("pos").Op("+=").Int().Call(jen.Id("n")),
+			)...),
+			jen.Case(jen.Id("def").Dot("IsPrimitive")).Block(
+				jen.Id("pos").Op("+=").Id("primitiveSizes").Index(jen.Id("def").Dot("Primitive").Dot("Si0TypeDefPrimitive")),
+			),
+			jen.Case(jen.Id("def").Dot("IsCompact")).Block(
+				jen.If(jen.List(jen.Id("_"), jen.Id("pos"), ok).Op("=").Id("skipCompact").Call(jen.Id("data"), jen.Id("pos")), jen.Op("!").Add(ok)).Block(fail),
+			),
+			jen.Case(jen.Id("def").Dot("IsBitSequence")).Block(
+				jen.Comment("A length in bits, then the bytes holding them"),
+				jen.List(jen.Id("n"), jen.Id("next"), ok).Op(":=").Id("skipCompact").Call(jen.Id("data"), jen.Id("pos")),
+				jen.If(jen.Op("!").Add(ok).Op("||").Id("n").Op(">").Lit(8).Op("*").Uint64().Call(jen.Len(jen.Id("data")).Op("-").Id("next"))).Block(fail),
+				jen.Id("pos").Op("=").Id("next").Op("+").Int().Call(jen.Parens(jen.Id("n").Op("+").Lit(7)).Op("/").Lit(8)),
+			),
+			jen.Default().Block(fail),
+		)
+		g.Return(jen.Id("pos"), jen.Id("pos").Op("<=").Len(jen.Id("data")))
+	})
+	f.Line()
+
+	f.Comment("Skip a compact integer at pos, returning its value, or the largest uint64 if it's larger, and the")
+	f.Comment("position after it")
+	f.Func().Id("skipCompact").Params(jen.Id("data").Index().Byte(), jen.Id("pos").Int()).Params(jen.Uint64(), jen.Int(), jen.Bool()).BlockFunc(func(g *jen.Group) {
+		compactFail := jen.Return(jen.Lit(0), jen.Id("pos"), jen.False())
+		g.If(jen.Id("pos").Op(">=").Len(jen.Id("data"))).Block(compactFail)
+		g.Var().Id("size").Int()
+		g.Switch(jen.Id("data").Index(jen.Id("pos")).Op("&").Lit(3)).Block(
+			jen.Case(jen.Lit(0)).Block(jen.Id("size").Op("=").Lit(1)),
+			jen.Case(jen.Lit(1)).Block(jen.Id("size").Op("=").Lit(2)),
+			jen.Case(jen.Lit(2)).Block(jen.Id("size").Op("=").Lit(4)),
+			jen.Default().Block(
+				jen.Comment("A byte of the length, then the integer"),
+				jen.Id("size").Op("=").Lit(1).Op("+").Int().Call(jen.Id("data").Index(jen.Id("pos")).Op(">>").Lit(2)).Op("+").Lit(4),
+			),
+		)
+		g.If(jen.Id("pos").Op("+").Id("size").Op(">").Len(jen.Id("data"))).Block(compactFail)
+		g.Var().Id("n").Uint64()
+		g.If(jen.Id("size").Op(">").Lit(9)).Block(
+			jen.Id("n").Op("=").Qual("math", "MaxUint64"),
+		).Else().If(jen.Id("size").Op(">").Lit(4)).Block(
+			jen.For(jen.Id("i").Op(":=").Id("size").Op("-").Lit(1), jen.Id("i").Op(">=").Lit(1), jen.Id("i").Op("--")).Block(
+				jen.Id("n").Op("=").Id("n").Op("<<").Lit(8).Op("|").Uint64().Call(jen.Id("data").Index(jen.Id("pos").Op("+").Id("i"))),
+			),
+		).Else().Block(
+			jen.For(jen.Id("i").Op(":=").Id("size").Op("-").Lit(1), jen.Id("i").Op(">=").Lit(0), jen.Id("i").Op("--")).Block(
+				jen.Id("n").Op("=").Id("n").Op("<<").Lit(8).Op("|").Uint64().Call(jen.Id("data").Index(jen.Id("pos").Op("+").Id("i"))),
+			),
+			jen.Id("n").Op(">>=").Lit(2),
+		)
+		g.Return(jen.Id("n"), jen.Id("pos").Op("+").Id("size"), jen.True())
+	})
+	f.Line()
+}
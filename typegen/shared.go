@@ -0,0 +1,134 @@
+package typegen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/aphoh/go-substrate-gen/utils"
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+)
+
+// Types shared between the packages generated for several runtime versions. A type is defined in
+// the first package which generates it, and the packages generated afterwards alias it, so values
+// of types which didn't change can be passed between the code of different versions.
+type SharedTypes struct {
+	// The first definition of each type, by the type's shared hash
+	defs map[string]sharedDef
+}
+
+type sharedDef struct {
+	pkg  string
+	name string
+}
+
+func NewSharedTypes() *SharedTypes {
+	return &SharedTypes{defs: map[string]sharedDef{}}
+}
+
+// Alias the type with the given name to an identical type generated before, if there is one.
+// Otherwise, the type is recorded so that later packages can alias it, and false is returned, in
+// which case the caller must define the type.
+//
+// example output:
+//
+//	// Generated pallet_balances_AccountData with id=5, shared with github.com/my/package/v9300/types
+//	type AccountData = types.AccountData
+func (tg *TypeGenerator) aliasShared(mt *types.PortableTypeV14, name string) bool {
+	if tg.Shared == nil {
+		return false
+	}
+	h, ok := tg.sharedHash(mt.ID.Int64())
+	if !ok {
+		return false
+	}
+	def, ok := tg.Shared.defs[h]
+	if !ok {
+		tg.Shared.defs[h] = sharedDef{pkg: tg.PkgPath, name: name}
+		return false
+	}
+
+	tg.F.Comment(fmt.Sprintf("Generated %v with id=%v, shared with %v", strings.Join(utils.PathStrs(mt.Type.Path), "_"), mt.ID.Int64(), def.pkg))
+	tg.F.Type().Id(name).Op("=").Qual(def.pkg, def.name)
+	return true
+}
+
+// Get a hash of the go representation of a type: its rust path, the names and rust type names of
+// its fields, its variants and the hashes of the types it contains. Types whose go definition may
+// differ between versions aren't shared: the runtime call, which gets extra methods, and recursive
+// types. Returns false for those.
+func (tg *TypeGenerator) sharedHash(id int64) (string, bool) {
+	if h, ok := tg.sharedHashes[id]; ok {
+		return h, h != ""
+	}
+	if tg.callId == nil {
+		if cid, err := getCallTypeId(tg.mtypes); err == nil {
+			tg.callId = &cid
+		}
+	}
+	mt, ok := tg.mtypes[id]
+	// Types on the current path are recursive, so they stay unshared
+	tg.sharedHashes[id] = ""
+	if !ok || (tg.callId != nil && *tg.callId == id) {
+		return "", false
+	}
+
+	d := sha256.New()
+	shareable := true
+	inner := func(typeId types.Si1LookupTypeID) {
+		h, ok := tg.sharedHash(typeId.Int64())
+		shareable = shareable && ok
+		fmt.Fprintf(d, "%v;", h)
+	}
+	fields := func(fs []types.Si1Field) {
+		fmt.Fprintf(d, "fields %v;", len(fs))
+		for _, f := range fs {
+			fmt.Fprintf(d, "%q %q ", f.Name, f.TypeName)
+			inner(f.Type)
+		}
+	}
+
+	fmt.Fprintf(d, "%q;", mt.Type.Path)
+	tdef := mt.Type.Def
+	switch {
+	case tdef.IsComposite:
+		fmt.Fprint(d, "composite;")
+		fields(tdef.Composite.Fields)
+	case tdef.IsVariant:
+		fmt.Fprintf(d, "variant %v;", len(tdef.Variant.Variants))
+		for _, v := range tdef.Variant.Variants {
+			fmt.Fprintf(d, "%v %q;", v.Index, v.Name)
+			fields(v.Fields)
+		}
+	case tdef.IsSequence:
+		fmt.Fprint(d, "sequence;")
+		inner(tdef.Sequence.Type)
+	case tdef.IsArray:
+		fmt.Fprintf(d, "array %v;", tdef.Array.Len)
+		inner(tdef.Array.Type)
+	case tdef.IsTuple:
+		fmt.Fprintf(d, "tuple %v;", len(tdef.Tuple))
+		for _, t := range tdef.Tuple {
+			inner(t)
+		}
+	case tdef.IsPrimitive:
+		fmt.Fprintf(d, "primitive %v;", tdef.Primitive.Si0TypeDefPrimitive)
+	case tdef.IsCompact:
+		fmt.Fprint(d, "compact;")
+		inner(tdef.Compact.Type)
+	case tdef.IsBitSequence:
+		fmt.Fprint(d, "bitsequence;")
+		inner(tdef.BitSequence.BitStoreType)
+		inner(tdef.BitSequence.BitOrderType)
+	default:
+		shareable = false
+	}
+	if !shareable {
+		return "", false
+	}
+
+	h := hex.EncodeToString(d.Sum(nil))
+	tg.sharedHashes[id] = h
+	return h, true
+}
@@ -0,0 +1,40 @@
+package typegen
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aphoh/go-substrate-gen/internal/testmeta"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedTypes(t *testing.T) {
+	meta := testmeta.Example(t)
+	var accountId int64 = -1
+	for _, ty := range meta.Lookup.Types {
+		p := ty.Type.Path
+		if len(p) == 2 && p[0] == "pallet_balances" && p[1] == "AccountData" {
+			accountId = ty.ID.Int64()
+		}
+	}
+	require.NotEqual(t, int64(-1), accountId)
+
+	shared := NewSharedTypes()
+	gen := func(pkgPath string) string {
+		tg := NewTypeGenerator(meta, "", pkgPath)
+		tg.Shared = shared
+		_, err := tg.GetType(accountId)
+		require.NoError(t, err)
+		_, err = tg.GetCallType()
+		require.NoError(t, err)
+		return tg.GetGenerated()
+	}
+
+	first := gen("example.com/v1/types")
+	require.Regexp(t, regexp.MustCompile(`type AccountData struct`), first)
+
+	// The second version aliases identical types, but defines its own call type, which gets methods
+	second := gen("example.com/v2/types")
+	require.Regexp(t, regexp.MustCompile(`type AccountData = \w+\.AccountData`), second)
+	require.Regexp(t, regexp.MustCompile(`type RuntimeCall struct`), second)
+}
@@ -81,6 +81,9 @@ func (tg *TypeGenerator) GenTuple(tup *types.Si1TypeDefTuple, mt *types.Portable
 		fName := utils.AsName("Elem", fmt.Sprint(i))
 		code = append(code, jen.Id(fName).Custom(utils.TypeOpts, ty.Code()))
 	}
+	if tg.aliasShared(mt, tn) {
+		return g, nil
+	}
 	tg.F.Comment(fmt.Sprintf("Tuple type generated from metadata id %v", mt.ID.Int64()))
 	tg.F.Type().Id(tn).Struct(code...)
 	return g, nil
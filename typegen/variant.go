@@ -75,6 +75,11 @@ func (tg *TypeGenerator) GenVariant(v *types.Si1TypeDefVariant, mt *types.Portab
 
 	}
 
+	// A type shared with another runtime version already has its methods
+	if tg.aliasShared(mt, vGend.Name) {
+		return vGend, nil
+	}
+
 	// Generate the variant type itself
 	tg.F.Comment(fmt.Sprintf("Generated %v with id=%v", utils.AsName(utils.PathStrs(mt.Type.Path)...), mt.ID.Int64()))
 	tg.F.Type().Id(vGend.Name).Struct(inner...)
@@ -207,10 +212,20 @@ func (tg *TypeGenerator) variantGenDecode(v *types.Si1TypeDefVariant, vGend *Var
 						g3.Id("ty").Dot(vGend.AsVarFields[i][0].Name).Op("=").Op("&").Id("tmp")
 					} else {
 						// decode remaining fields
-						for j := range variant.Fields {
-							g3.Err().Op("=").Id("decoder").Dot("Decode").Call(
-								jen.Op("&").Id("ty").Dot(vGend.AsVarFields[i][j].Name),
-							)
+						for j, f := range variant.Fields {
+							field := vGend.AsVarFields[i][j]
+							if !field.IsPtr {
+								g3.Err().Op("=").Id("decoder").Dot("Decode").Call(
+									jen.Op("&").Id("ty").Dot(field.Name),
+								)
+								utils.ErrorCheckG(g3)
+								continue
+							}
+							// The decoder can't decode into a nil pointer, so a boxed field needs one. The
+							// field's type was generated with the variant, so this can't fail
+							fieldTy, _ := tg.GetType(f.Type.Int64())
+							g3.Id("ty").Dot(field.Name).Op("=").New(jen.Custom(utils.TypeOpts, fieldTy.Code()))
+							g3.Err().Op("=").Id("decoder").Dot("Decode").Call(jen.Id("ty").Dot(field.Name))
 							utils.ErrorCheckG(g3)
 						}
 					}
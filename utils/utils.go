@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
@@ -12,6 +13,9 @@ const CTYPES = "github.com/centrifuge/go-substrate-rpc-client/v4/types"
 const CCODEC = "github.com/centrifuge/go-substrate-rpc-client/v4/types/codec"
 const GSRPC = "github.com/centrifuge/go-substrate-rpc-client/v4"
 const GSRPCState = "github.com/centrifuge/go-substrate-rpc-client/v4/rpc/state"
+const GSRPCClient = "github.com/centrifuge/go-substrate-rpc-client/v4/client"
+const GETHRPC = "github.com/centrifuge/go-substrate-rpc-client/v4/gethrpc"
+const GSRPCHash = "github.com/centrifuge/go-substrate-rpc-client/v4/hash"
 const TupleIface = "TupleIface"
 
 var TypeOpts = jen.Options{}
@@ -32,6 +36,9 @@ var rule = []string{"{", "",
 	" ", "",
 	"(", "",
 	")", "",
+	// Raw identifiers, like r#if
+	"r#", "",
+	"#", "",
 }
 
 // Replace one or more strings that may contain weird characters with a camelcased, valid go name.
@@ -60,6 +67,83 @@ func PathStrs(p types.Si1Path) (r []string) {
 	return
 }
 
+// The Rust names of primitives, by Si0TypeDefPrimitive
+var primitiveNames = []string{"bool", "char", "str", "u8", "u16", "u32", "u64", "u128", "u256", "i8", "i16", "i32", "i64", "i128", "i256"}
+
+// Name a type like Rust would: by its path and params if it has a path, e.g.
+// pallet_balances::AccountData<u128>, or else by its structure, e.g. (u32, Vec<u8>). Params without
+// a type are named by their name. Types nested deeper than `maxDepth` are named "...", since they
+// may be recursive, unless it's negative.
+func RustTypeName(lookup map[int64]types.PortableTypeV14, id int64, maxDepth int) string {
+	return rustTypeName(lookup, id, maxDepth, 0)
+}
+
+func rustTypeName(lookup map[int64]types.PortableTypeV14, id int64, maxDepth, depth int) string {
+	if maxDepth >= 0 && depth > maxDepth {
+		return "..."
+	}
+	mt, ok := lookup[id]
+	if !ok {
+		return fmt.Sprintf("<unknown type %v>", id)
+	}
+	list := func(ids ...types.Si1LookupTypeID) string {
+		names := []string{}
+		for _, id := range ids {
+			names = append(names, rustTypeName(lookup, id.Int64(), maxDepth, depth+1))
+		}
+		return strings.Join(names, ", ")
+	}
+	fields := func(fs []types.Si1Field) string {
+		names := []string{}
+		for _, f := range fs {
+			names = append(names, strings.TrimSpace(string(f.Name)+" "+list(f.Type)))
+		}
+		return "{" + strings.Join(names, ", ") + "}"
+	}
+
+	if len(mt.Type.Path) > 0 {
+		params := []string{}
+		for _, p := range mt.Type.Params {
+			if p.HasType {
+				params = append(params, list(p.Type))
+			} else {
+				params = append(params, string(p.Name))
+			}
+		}
+		name := strings.Join(PathStrs(mt.Type.Path), "::")
+		if len(params) > 0 {
+			name += "<" + strings.Join(params, ", ") + ">"
+		}
+		return name
+	}
+	tdef := mt.Type.Def
+	switch {
+	case tdef.IsSequence:
+		return "Vec<" + list(tdef.Sequence.Type) + ">"
+	case tdef.IsArray:
+		return fmt.Sprintf("[%v; %v]", list(tdef.Array.Type), tdef.Array.Len)
+	case tdef.IsTuple && len(tdef.Tuple) == 1:
+		return "(" + list(tdef.Tuple...) + ",)"
+	case tdef.IsTuple:
+		return "(" + list(tdef.Tuple...) + ")"
+	case tdef.IsPrimitive && int(tdef.Primitive.Si0TypeDefPrimitive) < len(primitiveNames):
+		return primitiveNames[tdef.Primitive.Si0TypeDefPrimitive]
+	case tdef.IsCompact:
+		return "Compact<" + list(tdef.Compact.Type) + ">"
+	case tdef.IsBitSequence:
+		return "BitVec<" + list(tdef.BitSequence.BitStoreType, tdef.BitSequence.BitOrderType) + ">"
+	case tdef.IsComposite:
+		return fields(tdef.Composite.Fields)
+	case tdef.IsVariant:
+		variants := []string{}
+		for _, v := range tdef.Variant.Variants {
+			variants = append(variants, string(v.Name)+fields(v.Fields))
+		}
+		return "enum{" + strings.Join(variants, ", ") + "}"
+	}
+	return fmt.Sprintf("<unknown type %v>", id)
+}
+
 // These functions operate on Jennifer groups. See here for documentation:
 // https://github.com/dave/jennifer#func-methods
 
@@ -101,3 +185,22 @@ func ErrorCheckWithNamedArgs(s *jen.Group) {
 		jen.Return(),
 	)
 }
+
+// Get the name of a storage hasher, like the builder's and FRAME's, e.g. Blake2_128Concat
+func HasherName(h types.StorageHasherV10) string {
+	switch {
+	case h.IsBlake2_128:
+		return "Blake2_128"
+	case h.IsBlake2_256:
+		return "Blake2_256"
+	case h.IsBlake2_128Concat:
+		return "Blake2_128Concat"
+	case h.IsTwox128:
+		return "Twox128"
+	case h.IsTwox256:
+		return "Twox256"
+	case h.IsTwox64Concat:
+		return "Twox64Concat"
+	}
+	return "Identity"
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/centrifuge/go-substrate-rpc-client/v4/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Raw identifiers, which let Rust use keywords as names, are named like the keyword
+func TestAsNameRawIdentifiers(t *testing.T) {
+	for name, want := range map[string]string{
+		"r#type":     "Type",
+		"r#ref_time": "RefTime",
+		"pays_fee":   "PaysFee",
+	} {
+		require.Equal(t, want, AsName(name), name)
+	}
+	require.Equal(t, "AsMatch0", AsName("As", "r#match", "0"))
+	require.Equal(t, "type0", AsArgName("r#type", "0"))
+}
+
+func TestRustTypeName(t *testing.T) {
+	lookup := map[int64]types.PortableTypeV14{}
+	def := func(id int64, ty types.Si1Type) {
+		lookup[id] = types.PortableTypeV14{ID: types.NewSi1LookupTypeIDFromUInt(uint64(id)), Type: ty}
+	}
+	ref := types.NewSi1LookupTypeIDFromUInt
+	def(0, types.Si1Type{Def: types.Si1TypeDef{IsPrimitive: true, Primitive: types.Si1TypeDefPrimitive{Si0TypeDefPrimitive: types.IsU8}}})
+	def(1, types.Si1Type{Def: types.Si1TypeDef{IsSequence: true, Sequence: types.Si1TypeDefSequence{Type: ref(0)}}})
+	def(2, types.Si1Type{Def: types.Si1TypeDef{IsTuple: true, Tuple: types.Si1TypeDefTuple{ref(1)}}})
+	def(3, types.Si1Type{
+		Path:   types.Si1Path{"bounded", "BoundedVec"},
+		Params: []types.Si1TypeParameter{{Name: "T", HasType: true, Type: ref(2)}, {Name: "S"}},
+		Def:    types.Si1TypeDef{IsComposite: true},
+	})
+	def(4, types.Si1Type{Def: types.Si1TypeDef{IsTuple: true, Tuple: types.Si1TypeDefTuple{ref(3), ref(0)}}})
+
+	require.Equal(t, "u8", RustTypeName(lookup, 0, -1))
+	// One-element tuples keep their comma, and params without a type are named
+	require.Equal(t, "(bounded::BoundedVec<(Vec<u8>,), S>, u8)", RustTypeName(lookup, 4, -1))
+	require.Equal(t, "(bounded::BoundedVec<(...,), S>, u8)", RustTypeName(lookup, 4, 2))
+	require.Equal(t, "<unknown type 5>", RustTypeName(lookup, 5, -1))
+}